@@ -0,0 +1,54 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_destination" "soc" {
+  name = "#security-monitoring"
+}
+
+# Destinations attached separately are merged with the ones already on the
+# rule. Ignore changes to the inline list so the rule owner does not remove
+# them again.
+resource "uptycs_alert_rule" "rule" {
+  name        = "marc test"
+  description = "Access Key created by an IAM user for another user using CreateAccessKey policy."
+  code        = "AWS_THREAT_PRIV_ESC_1_MARCUS"
+  type        = "sql"
+  rule        = "select * from processes WHERE users.upt_time >= :from AND users.upt_time < :to;"
+  grouping    = "ATTACK"
+  grouping_l2 = "Privilege Escalation"
+  grouping_l3 = "T1078"
+  throttled   = false
+  is_internal = false
+
+  destinations    = []
+  rule_exceptions = []
+  sql_config = {
+    interval_seconds = 3600
+  }
+
+  lifecycle {
+    ignore_changes = [destinations]
+  }
+}
+
+resource "uptycs_alert_rule_destination" "soc" {
+  rule_id              = uptycs_alert_rule.rule.id
+  destination_id       = data.uptycs_destination.soc.id
+  severity             = "high"
+  notify_every_alert   = true
+  close_after_delivery = false
+}
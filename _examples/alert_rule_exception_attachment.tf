@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_alert_rule" "rule" {
+  name = "AWS_THREAT_PRIV_ESC_1"
+}
+
+data "uptycs_exception" "build_hosts" {
+  name = "Test Account Exception"
+}
+
+# The rule itself can be managed elsewhere; if it is managed by terraform too,
+# add `lifecycle { ignore_changes = [rule_exceptions] }` to it.
+resource "uptycs_alert_rule_exception_attachment" "build_hosts" {
+  rule_id      = data.uptycs_alert_rule.rule.id
+  exception_id = data.uptycs_exception.build_hosts.id
+}
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_alert_rules" "privesc" {
+  grouping    = "ATTACK"
+  grouping_l2 = "Privilege Escalation"
+  enabled     = true
+  is_internal = false
+  type        = "sql"
+  name_regex  = "(?i)^marc"
+}
+
+output "privesc_alert_rule_ids" {
+  value = data.uptycs_alert_rules.privesc.ids
+}
+
+output "privesc_alert_rule_names" {
+  value = [for r in data.uptycs_alert_rules.privesc.alert_rules : r.name]
+}
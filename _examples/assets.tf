@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_assets" "prod_web" {
+  hostname         = "web-*"
+  platform         = "ubuntu"
+  tag              = "asset-group=prod"
+  last_seen_within = "24h"
+}
+
+check "prod_web_tagged" {
+  assert {
+    condition     = length(data.uptycs_assets.prod_web.ids) >= 10
+    error_message = "Expected the prod tag rule to match at least 10 web hosts."
+  }
+}
+
+output "prod_web_osquery_versions" {
+  value = { for a in data.uptycs_assets.prod_web.assets : a.hostname => a.osquery_version }
+}
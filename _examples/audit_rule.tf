@@ -0,0 +1,57 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_audit_rule" "ar" {
+  name = "Watch passwd"
+}
+
+resource "uptycs_audit_rule" "passwd" {
+  name        = "marc passwd"
+  description = "watch writes to passwd"
+  rule        = "-w /etc/passwd -p wa -k passwd_changes"
+}
+
+resource "uptycs_audit_rule" "sudoers" {
+  name = "marc sudoers"
+  rule = "-w /etc/sudoers -p wa -k sudoers_changes"
+}
+
+resource "uptycs_audit_group" "new_ag" {
+  name        = "marc test"
+  description = "identity files"
+  audit_rules = [
+    uptycs_audit_rule.passwd.id,
+    uptycs_audit_rule.sudoers.id,
+  ]
+}
+
+resource "uptycs_tag" "audit_tag" {
+  key                    = "auditd"
+  value                  = "marc"
+  audit_groups           = [uptycs_audit_group.new_ag.id]
+  audit_rules            = [uptycs_audit_rule.passwd.id]
+  file_path_groups       = []
+  audit_configurations   = []
+  event_exclude_profiles = []
+  querypacks             = []
+  registry_paths         = []
+  yara_group_rules       = []
+}
+
+output "ar" {
+  value = data.uptycs_audit_rule.ar
+}
@@ -23,9 +23,10 @@ data "uptycs_destination" "foo" {
 }
 
 resource "uptycs_destination" "test" {
-  name    = "marc test"
-  address = "marcus.young@foo.com"
-  type    = "email"
+  name                = "marc test"
+  address             = "marcus.young@foo.com"
+  type                = "email"
+  deletion_protection = true
 }
 
 resource "uptycs_destination" "test2" {
@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+data "uptycs_dns_block_rule" "dbr" {
+  name = "Block known bad domains"
+}
+
+resource "uptycs_dns_block_rule" "new_dbr" {
+  name        = "marc test"
+  description = "block some known bad domains"
+  enabled     = true
+  rules = [
+    {
+      name = "example"
+      rule = "*.bad.example.com"
+    },
+  ]
+}
+
+resource "uptycs_tag" "dbr_tag" {
+  key                    = "dns-block"
+  value                  = "marc"
+  dns_block_rule         = uptycs_dns_block_rule.new_dbr.id
+  file_path_groups       = []
+  audit_configurations   = []
+  event_exclude_profiles = []
+  querypacks             = []
+  registry_paths         = []
+  yara_group_rules       = []
+}
+
+output "dbr" {
+  value = data.uptycs_dns_block_rule.dbr
+}
@@ -23,6 +23,7 @@ resource "uptycs_event_rule" "Access_Key_Created" {
   grouping_l3 = "T1078"
   code        = "AWS_THREAT_PRIV_ESC_1"
   type        = "builder"
+  on_destroy  = "disable"
   alert_rule = {
     destinations = [
       {
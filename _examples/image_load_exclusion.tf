@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_image_load_exclusion" "ile" {
+  name = "Signed Microsoft images"
+}
+
+resource "uptycs_image_load_exclusion" "new_ile" {
+  name         = "marc test"
+  description  = "noisy dotnet image loads"
+  image_path   = "C:\\Windows\\Microsoft.NET\\%"
+  process_path = "C:\\Program Files\\Builder\\builder.exe"
+  enabled      = true
+}
+
+resource "uptycs_tag" "ile_tag" {
+  key                    = "image-load"
+  value                  = "marc"
+  image_load_exclusions  = [uptycs_image_load_exclusion.new_ile.id]
+  file_path_groups       = []
+  audit_configurations   = []
+  event_exclude_profiles = []
+  querypacks             = []
+  registry_paths         = []
+  yara_group_rules       = []
+}
+
+output "ile" {
+  value = data.uptycs_image_load_exclusion.ile
+}
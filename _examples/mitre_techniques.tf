@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_mitre_techniques" "collection" {
+  tactic = "Collection"
+}
+
+# grouping_l2 and grouping_l3 are checked against the same catalogue when
+# grouping = "MITRE"
+resource "uptycs_alert_rule" "archive_collected_data" {
+  name            = "marc archive collected data"
+  description     = "archive utilities run against home directories"
+  code            = "MARC_T1560"
+  type            = "sql"
+  rule            = "select * from process_events where upt_time >= :from and upt_time < :to and name in ('zip', 'tar', '7z')"
+  grouping        = "MITRE"
+  grouping_l2     = "Collection"
+  grouping_l3     = "T1560"
+  enabled         = false
+  throttled       = false
+  is_internal     = false
+  notify_count    = 0
+  notify_interval = 0
+  alert_tags      = []
+  rule_exceptions = []
+  destinations    = []
+  sql_config = {
+    interval_seconds = 3600
+  }
+}
+
+output "collection_techniques" {
+  value = { for t in data.uptycs_mitre_techniques.collection.techniques : t.id => t.name }
+}
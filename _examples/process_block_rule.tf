@@ -0,0 +1,52 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+data "uptycs_process_block_rule" "pbr" {
+  name = "Block crypto miners"
+}
+
+resource "uptycs_process_block_rule" "new_pbr" {
+  name        = "marc test"
+  description = "block some well known miners"
+  enabled     = true
+  rules = [
+    {
+      name = "xmrig"
+      rule = "/usr/bin/xmrig"
+    },
+    {
+      name        = "minerd"
+      description = "cpuminer"
+      rule        = "/usr/local/bin/minerd"
+    },
+  ]
+}
+
+resource "uptycs_tag" "pbr_tag" {
+  key                    = "process-block"
+  value                  = "marc"
+  process_block_rule     = uptycs_process_block_rule.new_pbr.id
+  file_path_groups       = []
+  audit_configurations   = []
+  event_exclude_profiles = []
+  querypacks             = []
+  registry_paths         = []
+  yara_group_rules       = []
+}
+
+output "pbr" {
+  value = data.uptycs_process_block_rule.pbr
+}
@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_prometheus_target" "prom" {
+  name = "node exporters"
+}
+
+resource "uptycs_prometheus_target" "new_prom" {
+  name            = "marc test"
+  description     = "kube node exporters"
+  urls            = ["http://localhost:9100/metrics", "http://localhost:10249/metrics"]
+  scrape_interval = 30
+  labels = {
+    cluster = "prod-east"
+    team    = "platform"
+  }
+}
+
+resource "uptycs_tag" "prom_tag" {
+  key                    = "prometheus"
+  value                  = "marc"
+  prometheus_targets     = [uptycs_prometheus_target.new_prom.id]
+  file_path_groups       = []
+  audit_configurations   = []
+  event_exclude_profiles = []
+  querypacks             = []
+  registry_paths         = []
+  yara_group_rules       = []
+}
+
+output "prom" {
+  value = data.uptycs_prometheus_target.prom
+}
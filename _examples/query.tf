@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_query" "web_hosts" {
+  query    = "SELECT upt_asset_id, upt_hostname FROM upt_assets WHERE upt_hostname LIKE 'web-%'"
+  type     = "global"
+  timeout  = 120
+  max_rows = 500
+}
+
+resource "uptycs_tag" "web_hosts" {
+  for_each = { for r in data.uptycs_query.web_hosts.rows : r.upt_asset_id => r }
+
+  key                    = "host"
+  value                  = each.value.upt_hostname
+  file_path_groups       = []
+  audit_configurations   = []
+  event_exclude_profiles = []
+  querypacks             = []
+  registry_paths         = []
+  yara_group_rules       = []
+}
@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_redaction" "red" {
+  name = "PII redactions"
+}
+
+resource "uptycs_redaction" "new_red" {
+  name        = "marc test"
+  description = "scrub credentials from process arguments"
+  enabled     = true
+  rules = [
+    {
+      table_name  = "process_events"
+      column_name = "cmdline"
+      regex       = "(?i)password=\\S+"
+    },
+    {
+      table_name  = "users"
+      column_name = "description"
+    },
+  ]
+}
+
+resource "uptycs_tag" "red_tag" {
+  key                    = "redaction"
+  value                  = "marc"
+  redactions             = [uptycs_redaction.new_red.id]
+  file_path_groups       = []
+  audit_configurations   = []
+  event_exclude_profiles = []
+  querypacks             = []
+  registry_paths         = []
+  yara_group_rules       = []
+}
+
+output "red" {
+  value = data.uptycs_redaction.red
+}
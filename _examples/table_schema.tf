@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_table_schema" "process_events" {
+  name = "process_events"
+}
+
+output "process_events_columns" {
+  value = [for c in data.uptycs_table_schema.process_events.tables[0].columns : "${c.name} (${c.type})"]
+}
@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_tags" "asset_groups" {
+  key    = "asset-group"
+  value  = "prod-*"
+  source = "direct"
+  system = false
+}
+
+output "tags_with_flag_profile" {
+  value = [for t in data.uptycs_tags.asset_groups.tags : "${t.key}=${t.value}" if t.flag_profile != ""]
+}
+
+output "tags_with_compliance_profile" {
+  value = [for t in data.uptycs_tags.asset_groups.tags : "${t.key}=${t.value}" if t.compliance_profile != ""]
+}
@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_users" "active_humans" {
+  active = true
+  bot    = false
+}
+
+data "uptycs_users" "admins" {
+  role = "admin"
+}
+
+data "uptycs_roles" "all" {}
+
+output "access_review" {
+  value = {
+    for u in data.uptycs_users.active_humans.users : u.email => {
+      super_admin = u.super_admin
+      roles       = u.role_names
+    }
+  }
+}
+
+output "admin_ids" {
+  value = data.uptycs_users.admins.ids
+}
+
+output "role_permissions" {
+  value = { for r in data.uptycs_roles.all.roles : r.name => r.permissions }
+}
@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    uptycs = {
+      source  = "uptycslabs/uptycs"
+      version = "0.0.26"
+    }
+  }
+}
+
+provider "uptycs" {
+  host        = "https://test.uptycs.io"
+  customer_id = "11111111-1111-1111-1111-11111111111"
+  api_key     = "2222222222222222222222"
+  api_secret  = "234444444444433333333333222222221111111"
+}
+
+
+data "uptycs_windows_defender_preference" "wdp" {
+  name = "Default Defender settings"
+}
+
+resource "uptycs_windows_defender_preference" "new_wdp" {
+  name        = "marc test"
+  description = "strict defender settings"
+  preferences = {
+    disable_realtime_monitoring     = false
+    pua_protection                  = "enabled"
+    cloud_block_level               = "high"
+    cloud_extended_timeout          = 50
+    maps_reporting                  = "advanced"
+    submit_samples_consent          = "send_safe_samples"
+    enable_network_protection       = "audit"
+    enable_controlled_folder_access = "audit"
+    signature_update_interval       = 4
+    exclusion_paths                 = ["C:\\Temp\\builds"]
+    exclusion_extensions            = [".log"]
+    exclusion_processes             = []
+  }
+}
+
+resource "uptycs_tag" "wdp_tag" {
+  key                         = "defender"
+  value                       = "marc"
+  windows_defender_preference = uptycs_windows_defender_preference.new_wdp.id
+  file_path_groups            = []
+  audit_configurations        = []
+  event_exclude_profiles      = []
+  querypacks                  = []
+  registry_paths              = []
+  yara_group_rules            = []
+}
+
+output "wdp" {
+  value = data.uptycs_windows_defender_preference.wdp
+}
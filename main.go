@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"terraform-provider-uptycs/uptycs"
@@ -12,6 +15,14 @@ import (
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting configuration: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	err := providerserver.Serve(
 		context.Background(),
 		uptycs.New,
@@ -24,3 +35,37 @@ func main() {
 		panic(fmt.Sprintf("Error serving provider: %v", err))
 	}
 }
+
+func export(args []string) error {
+	var cfg uptycs.ExportConfig
+	var types, defaultAlertTags, defaultEventTags string
+
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	fs.StringVar(&cfg.Host, "host", os.Getenv("UPTYCS_HOST"), "Uptycs host (defaults to UPTYCS_HOST)")
+	fs.StringVar(&cfg.APIKey, "api-key", os.Getenv("UPTYCS_API_KEY"), "API key (defaults to UPTYCS_API_KEY)")
+	fs.StringVar(&cfg.APISecret, "api-secret", os.Getenv("UPTYCS_API_SECRET"), "API secret (defaults to UPTYCS_API_SECRET)")
+	fs.StringVar(&cfg.CustomerID, "customer-id", os.Getenv("UPTYCS_CUSTOMER_ID"), "Customer ID (defaults to UPTYCS_CUSTOMER_ID)")
+	fs.StringVar(&cfg.Dir, "dir", ".", "Directory to write the generated .tf files to")
+	fs.StringVar(&types, "types", "", "Comma separated resource types to export, e.g. uptycs_alert_rule,uptycs_tag (defaults to all)")
+	fs.StringVar(&cfg.NamePrefix, "name-prefix", "", "The provider's name_prefix, stripped from exported names")
+	fs.StringVar(&defaultAlertTags, "default-alert-tags", "", "Comma separated default_alert_tags of the provider, left out of exported alert tags")
+	fs.StringVar(&defaultEventTags, "default-event-tags", "", "Comma separated default_event_tags of the provider, left out of exported event tags")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if cfg.Host == "" || cfg.APIKey == "" || cfg.APISecret == "" || cfg.CustomerID == "" {
+		return fmt.Errorf("host, api-key, api-secret and customer-id are required")
+	}
+	if types != "" {
+		cfg.Types = strings.Split(types, ",")
+	}
+	if defaultAlertTags != "" {
+		cfg.DefaultAlertTags = strings.Split(defaultAlertTags, ",")
+	}
+	if defaultEventTags != "" {
+		cfg.DefaultEventTags = strings.Split(defaultEventTags, ",")
+	}
+
+	return uptycs.Export(context.Background(), cfg)
+}
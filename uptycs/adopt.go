@@ -0,0 +1,61 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+)
+
+// isNotFound reports whether err is the client saying that nothing matched a
+// lookup. The client doesn't return typed errors, so this goes by the message.
+func isNotFound(err error) bool {
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "not found") || strings.Contains(message, "404")
+}
+
+// lookupExisting returns the ID an adopt_existing lookup found, or "" if
+// nothing matched. Any other lookup failure is added to diags, so that a
+// flaky API doesn't quietly turn an adoption into a duplicate.
+func lookupExisting(id string, err error, description string, diags *diag.Diagnostics) string {
+	if err != nil {
+		if !isNotFound(err) {
+			diags.AddError(
+				"Error creating",
+				"Could not look up existing "+description+": "+err.Error(),
+			)
+		}
+		return ""
+	}
+	return id
+}
+
+// adoptExisting takes ownership of an object that already exists in the
+// tenant instead of creating a duplicate: the object is read into state and
+// then updated to match the plan.
+func adoptExisting(ctx context.Context, r resource.Resource, id string, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Adopting existing object", map[string]any{"id": id})
+
+	state, diags := readResourceState(ctx, r, tfsdk.State{Schema: req.Plan.Schema}, id)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{
+		Config:       req.Config,
+		Plan:         req.Plan,
+		State:        state,
+		ProviderMeta: req.ProviderMeta,
+	}, updateResp)
+	resp.Diagnostics.Append(updateResp.Diagnostics...)
+	if resp.Diagnostics.HasError() {
+		// Leave the state empty: saving the adopted object here would taint
+		// it, and the next apply would destroy an object terraform didn't create
+		return
+	}
+	resp.State = updateResp.State
+}
@@ -105,7 +105,7 @@ func (d *alertRuleDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	var result = AlertRule{
+	var result = AlertRuleData{
 		ID:                  types.StringValue(alertRuleResp.ID),
 		Name:                types.StringValue(alertRuleResp.Name),
 		Description:         types.StringValue(alertRuleResp.Description),
@@ -0,0 +1,133 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"regexp"
+)
+
+func AlertRulesDataSource() datasource.DataSource {
+	return &alertRulesDataSource{}
+}
+
+type alertRulesDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *alertRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rules"
+}
+
+func (d *alertRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *alertRulesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"grouping":    schema.StringAttribute{Optional: true},
+			"grouping_l2": schema.StringAttribute{Optional: true},
+			"enabled":     schema.BoolAttribute{Optional: true},
+			"is_internal": schema.BoolAttribute{Optional: true},
+			"type":        schema.StringAttribute{Optional: true},
+			"name_regex":  schema.StringAttribute{Optional: true},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"alert_rules": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.StringAttribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+						"code":        schema.StringAttribute{Computed: true},
+						"type":        schema.StringAttribute{Computed: true},
+						"grouping":    schema.StringAttribute{Computed: true},
+						"grouping_l2": schema.StringAttribute{Computed: true},
+						"grouping_l3": schema.StringAttribute{Computed: true},
+						"enabled":     schema.BoolAttribute{Computed: true},
+						"is_internal": schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *alertRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config AlertRules
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		if nameRegex, err = regexp.Compile(config.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read.",
+				"Invalid name_regex "+config.NameRegex.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	alertRulesResp, err := d.client.GetAlertRules()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get alertRules: "+err.Error(),
+		)
+		return
+	}
+
+	ids := make([]string, 0)
+	alertRules := make([]AlertRulesItem, 0)
+	for _, ar := range alertRulesResp.Items {
+		if !matchesStringFilter(config.Grouping, ar.Grouping) ||
+			!matchesStringFilter(config.GroupingL2, ar.GroupingL2) ||
+			!matchesStringFilter(config.Type, ar.Type) ||
+			!matchesBoolFilter(config.Enabled, ar.Enabled) ||
+			!matchesBoolFilter(config.IsInternal, ar.IsInternal) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(ar.Name) {
+			continue
+		}
+
+		ids = append(ids, ar.ID)
+		alertRules = append(alertRules, AlertRulesItem{
+			ID:          types.StringValue(ar.ID),
+			Name:        types.StringValue(ar.Name),
+			Description: types.StringValue(ar.Description),
+			Code:        types.StringValue(ar.Code),
+			Type:        types.StringValue(ar.Type),
+			Grouping:    types.StringValue(ar.Grouping),
+			GroupingL2:  types.StringValue(ar.GroupingL2),
+			GroupingL3:  types.StringValue(ar.GroupingL3),
+			Enabled:     types.BoolValue(ar.Enabled),
+			IsInternal:  types.BoolValue(ar.IsInternal),
+		})
+	}
+
+	config.IDs = makeListStringAttribute(ids)
+	config.AlertRules = alertRules
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
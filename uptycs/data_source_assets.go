@@ -0,0 +1,156 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"golang.org/x/exp/slices"
+	"path"
+	"time"
+)
+
+func AssetsDataSource() datasource.DataSource {
+	return &assetsDataSource{}
+}
+
+type assetsDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *assetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assets"
+}
+
+func (d *assetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *assetsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hostname":         schema.StringAttribute{Optional: true},
+			"os":               schema.StringAttribute{Optional: true},
+			"platform":         schema.StringAttribute{Optional: true},
+			"tag":              schema.StringAttribute{Optional: true},
+			"object_group":     schema.StringAttribute{Optional: true},
+			"last_seen_within": schema.StringAttribute{Optional: true},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"assets": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":              schema.StringAttribute{Computed: true},
+						"hostname":        schema.StringAttribute{Computed: true},
+						"os":              schema.StringAttribute{Computed: true},
+						"os_version":      schema.StringAttribute{Computed: true},
+						"platform":        schema.StringAttribute{Computed: true},
+						"osquery_version": schema.StringAttribute{Computed: true},
+						"object_group":    schema.StringAttribute{Computed: true},
+						"last_seen":       schema.StringAttribute{Computed: true},
+						"tags": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *assetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Assets
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Hostname.IsNull() {
+		if _, err := path.Match(config.Hostname.ValueString(), ""); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read.",
+				"Invalid hostname pattern "+config.Hostname.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var seenAfter time.Time
+	if !config.LastSeenWithin.IsNull() {
+		window, err := time.ParseDuration(config.LastSeenWithin.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read.",
+				"Invalid last_seen_within "+config.LastSeenWithin.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		seenAfter = time.Now().Add(-window)
+	}
+
+	assetsResp, err := d.client.GetAssets()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get assets: "+err.Error(),
+		)
+		return
+	}
+
+	ids := make([]string, 0)
+	assets := make([]AssetsItem, 0)
+	for _, a := range assetsResp.Items {
+		if !matchesStringFilter(config.Os, a.Os) ||
+			!matchesStringFilter(config.Platform, a.Platform) ||
+			!matchesStringFilter(config.ObjectGroup, a.ObjectGroupID) {
+			continue
+		}
+		if !config.Hostname.IsNull() {
+			if matched, _ := path.Match(config.Hostname.ValueString(), a.HostName); !matched {
+				continue
+			}
+		}
+		if !config.Tag.IsNull() && !slices.Contains(a.Tags, config.Tag.ValueString()) {
+			continue
+		}
+		if !seenAfter.IsZero() {
+			lastSeen, err := time.Parse(time.RFC3339, a.LastActivityAt)
+			if err != nil || lastSeen.Before(seenAfter) {
+				continue
+			}
+		}
+
+		ids = append(ids, a.ID)
+		assets = append(assets, AssetsItem{
+			ID:             types.StringValue(a.ID),
+			Hostname:       types.StringValue(a.HostName),
+			Os:             types.StringValue(a.Os),
+			OsVersion:      types.StringValue(a.OsVersion),
+			Platform:       types.StringValue(a.Platform),
+			OsqueryVersion: types.StringValue(a.OsqueryVersion),
+			ObjectGroup:    types.StringValue(a.ObjectGroupID),
+			LastSeen:       types.StringValue(a.LastActivityAt),
+			Tags:           makeListStringAttribute(a.Tags),
+		})
+	}
+
+	config.IDs = makeListStringAttribute(ids)
+	config.Assets = assets
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
@@ -0,0 +1,86 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func AuditGroupDataSource() datasource.DataSource {
+	return &auditGroupDataSource{}
+}
+
+type auditGroupDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *auditGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_group"
+}
+
+func (d *auditGroupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *auditGroupDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Optional: true},
+			"name":        schema.StringAttribute{Optional: true},
+			"description": schema.StringAttribute{Optional: true},
+			"audit_rules": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+		},
+	}
+}
+
+func (d *auditGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var auditGroupID string
+	var auditGroupName string
+
+	idAttr := req.Config.GetAttribute(ctx, path.Root("id"), &auditGroupID)
+	nameAttr := req.Config.GetAttribute(ctx, path.Root("name"), &auditGroupName)
+
+	var auditGroupToLookup uptycs.AuditGroup
+
+	if len(auditGroupID) == 0 {
+		resp.Diagnostics.Append(nameAttr...)
+		auditGroupToLookup = uptycs.AuditGroup{
+			Name: auditGroupName,
+		}
+	} else {
+		resp.Diagnostics.Append(idAttr...)
+		auditGroupToLookup = uptycs.AuditGroup{
+			ID: auditGroupID,
+		}
+	}
+
+	auditGroupResp, err := d.client.GetAuditGroup(auditGroupToLookup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get auditGroup with ID  "+auditGroupID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = AuditGroup{
+		ID:          types.StringValue(auditGroupResp.ID),
+		Name:        types.StringValue(auditGroupResp.Name),
+		Description: types.StringValue(auditGroupResp.Description),
+		AuditRules:  makeListStringAttribute(auditGroupResp.AuditRules),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
@@ -0,0 +1,88 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func AuditRuleDataSource() datasource.DataSource {
+	return &auditRuleDataSource{}
+}
+
+type auditRuleDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *auditRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_rule"
+}
+
+func (d *auditRuleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *auditRuleDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Optional: true},
+			"name":        schema.StringAttribute{Optional: true},
+			"description": schema.StringAttribute{Optional: true},
+			"rule":        schema.StringAttribute{Optional: true},
+			"enabled":     schema.BoolAttribute{Optional: true},
+		},
+	}
+}
+
+func (d *auditRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var auditRuleID string
+	var auditRuleName string
+
+	idAttr := req.Config.GetAttribute(ctx, path.Root("id"), &auditRuleID)
+	nameAttr := req.Config.GetAttribute(ctx, path.Root("name"), &auditRuleName)
+
+	var auditRuleToLookup uptycs.AuditRule
+
+	if len(auditRuleID) == 0 {
+		resp.Diagnostics.Append(nameAttr...)
+		auditRuleToLookup = uptycs.AuditRule{
+			Name: auditRuleName,
+		}
+	} else {
+		resp.Diagnostics.Append(idAttr...)
+		auditRuleToLookup = uptycs.AuditRule{
+			ID: auditRuleID,
+		}
+	}
+
+	auditRuleResp, err := d.client.GetAuditRule(auditRuleToLookup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get auditRule with ID  "+auditRuleID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = AuditRule{
+		ID:          types.StringValue(auditRuleResp.ID),
+		Name:        types.StringValue(auditRuleResp.Name),
+		Description: types.StringValue(auditRuleResp.Description),
+		Rule:        types.StringValue(auditRuleResp.Rule),
+		Enabled:     types.BoolValue(auditRuleResp.Enabled),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
@@ -85,7 +85,7 @@ func (d *destinationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	var result = Destination{
+	var result = DestinationData{
 		ID:      types.StringValue(destinationResp.ID),
 		Name:    types.StringValue(destinationResp.Name),
 		Type:    types.StringValue(destinationResp.Type),
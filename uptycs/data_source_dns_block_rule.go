@@ -0,0 +1,106 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func DNSBlockRuleDataSource() datasource.DataSource {
+	return &dnsBlockRuleDataSource{}
+}
+
+type dnsBlockRuleDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *dnsBlockRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_block_rule"
+}
+
+func (d *dnsBlockRuleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *dnsBlockRuleDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Optional: true},
+			"name":        schema.StringAttribute{Optional: true},
+			"description": schema.StringAttribute{Optional: true},
+			"enabled":     schema.BoolAttribute{Optional: true},
+			"rules": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":        schema.StringAttribute{Optional: true},
+						"description": schema.StringAttribute{Optional: true},
+						"rule":        schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dnsBlockRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var dnsBlockRuleID string
+	var dnsBlockRuleName string
+
+	idAttr := req.Config.GetAttribute(ctx, path.Root("id"), &dnsBlockRuleID)
+	nameAttr := req.Config.GetAttribute(ctx, path.Root("name"), &dnsBlockRuleName)
+
+	var dnsBlockRuleToLookup uptycs.DNSBlockRule
+
+	if len(dnsBlockRuleID) == 0 {
+		resp.Diagnostics.Append(nameAttr...)
+		dnsBlockRuleToLookup = uptycs.DNSBlockRule{
+			Name: dnsBlockRuleName,
+		}
+	} else {
+		resp.Diagnostics.Append(idAttr...)
+		dnsBlockRuleToLookup = uptycs.DNSBlockRule{
+			ID: dnsBlockRuleID,
+		}
+	}
+
+	dnsBlockRuleResp, err := d.client.GetDNSBlockRule(dnsBlockRuleToLookup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get dnsBlockRule with ID  "+dnsBlockRuleID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = DNSBlockRule{
+		ID:          types.StringValue(dnsBlockRuleResp.ID),
+		Name:        types.StringValue(dnsBlockRuleResp.Name),
+		Description: types.StringValue(dnsBlockRuleResp.Description),
+		Enabled:     types.BoolValue(dnsBlockRuleResp.Enabled),
+	}
+
+	var rules []BlockRuleEntry
+	for _, _r := range dnsBlockRuleResp.Rules {
+		rules = append(rules, BlockRuleEntry{
+			Name:        types.StringValue(_r.Name),
+			Description: types.StringValue(_r.Description),
+			Rule:        types.StringValue(_r.Rule),
+		})
+	}
+	result.Rules = rules
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
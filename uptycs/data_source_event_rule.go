@@ -122,7 +122,7 @@ func (d *eventRuleDataSource) Read(ctx context.Context, req datasource.ReadReque
 		fmt.Println(err)
 	}
 
-	var result = EventRule{
+	var result = EventRuleData{
 		ID:          types.StringValue(eventRuleResp.ID),
 		Enabled:     types.BoolValue(eventRuleResp.Enabled),
 		Name:        types.StringValue(eventRuleResp.Name),
@@ -82,7 +82,7 @@ func (d *exceptionDataSource) Read(ctx context.Context, req datasource.ReadReque
 		fmt.Println(err)
 	}
 
-	var result = Exception{
+	var result = ExceptionData{
 		ID:              types.StringValue(exceptionResp.ID),
 		Name:            types.StringValue(exceptionResp.Name),
 		Description:     types.StringValue(exceptionResp.Description),
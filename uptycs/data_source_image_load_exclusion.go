@@ -0,0 +1,90 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func ImageLoadExclusionDataSource() datasource.DataSource {
+	return &imageLoadExclusionDataSource{}
+}
+
+type imageLoadExclusionDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *imageLoadExclusionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_load_exclusion"
+}
+
+func (d *imageLoadExclusionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *imageLoadExclusionDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":           schema.StringAttribute{Optional: true},
+			"name":         schema.StringAttribute{Optional: true},
+			"description":  schema.StringAttribute{Optional: true},
+			"image_path":   schema.StringAttribute{Optional: true},
+			"process_path": schema.StringAttribute{Optional: true},
+			"enabled":      schema.BoolAttribute{Optional: true},
+		},
+	}
+}
+
+func (d *imageLoadExclusionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var imageLoadExclusionID string
+	var imageLoadExclusionName string
+
+	idAttr := req.Config.GetAttribute(ctx, path.Root("id"), &imageLoadExclusionID)
+	nameAttr := req.Config.GetAttribute(ctx, path.Root("name"), &imageLoadExclusionName)
+
+	var imageLoadExclusionToLookup uptycs.ImageLoadExclusion
+
+	if len(imageLoadExclusionID) == 0 {
+		resp.Diagnostics.Append(nameAttr...)
+		imageLoadExclusionToLookup = uptycs.ImageLoadExclusion{
+			Name: imageLoadExclusionName,
+		}
+	} else {
+		resp.Diagnostics.Append(idAttr...)
+		imageLoadExclusionToLookup = uptycs.ImageLoadExclusion{
+			ID: imageLoadExclusionID,
+		}
+	}
+
+	imageLoadExclusionResp, err := d.client.GetImageLoadExclusion(imageLoadExclusionToLookup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get imageLoadExclusion with ID  "+imageLoadExclusionID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = ImageLoadExclusion{
+		ID:          types.StringValue(imageLoadExclusionResp.ID),
+		Name:        types.StringValue(imageLoadExclusionResp.Name),
+		Description: types.StringValue(imageLoadExclusionResp.Description),
+		ImagePath:   types.StringValue(imageLoadExclusionResp.ImagePath),
+		ProcessPath: types.StringValue(imageLoadExclusionResp.ProcessPath),
+		Enabled:     types.BoolValue(imageLoadExclusionResp.Enabled),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
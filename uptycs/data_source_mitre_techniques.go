@@ -0,0 +1,75 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+)
+
+func MITRETechniquesDataSource() datasource.DataSource {
+	return &mitreTechniquesDataSource{}
+}
+
+type mitreTechniquesDataSource struct{}
+
+func (d *mitreTechniquesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mitre_techniques"
+}
+
+func (d *mitreTechniquesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tactic": schema.StringAttribute{Optional: true},
+			"tactics": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"techniques": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":   schema.StringAttribute{Computed: true},
+						"name": schema.StringAttribute{Computed: true},
+						"tactics": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *mitreTechniquesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config MITRETechniques
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	techniques := make([]MITRETechnique, 0)
+	for _, t := range mitreTechniques {
+		if !config.Tactic.IsNull() && !slices.Contains(t.Tactics, config.Tactic.ValueString()) {
+			continue
+		}
+		techniques = append(techniques, MITRETechnique{
+			ID:      types.StringValue(t.ID),
+			Name:    types.StringValue(t.Name),
+			Tactics: makeListStringAttribute(t.Tactics),
+		})
+	}
+
+	config.Tactics = makeListStringAttribute(mitreTactics)
+	config.Techniques = techniques
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
@@ -0,0 +1,106 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func ProcessBlockRuleDataSource() datasource.DataSource {
+	return &processBlockRuleDataSource{}
+}
+
+type processBlockRuleDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *processBlockRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_block_rule"
+}
+
+func (d *processBlockRuleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *processBlockRuleDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Optional: true},
+			"name":        schema.StringAttribute{Optional: true},
+			"description": schema.StringAttribute{Optional: true},
+			"enabled":     schema.BoolAttribute{Optional: true},
+			"rules": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":        schema.StringAttribute{Optional: true},
+						"description": schema.StringAttribute{Optional: true},
+						"rule":        schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *processBlockRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var processBlockRuleID string
+	var processBlockRuleName string
+
+	idAttr := req.Config.GetAttribute(ctx, path.Root("id"), &processBlockRuleID)
+	nameAttr := req.Config.GetAttribute(ctx, path.Root("name"), &processBlockRuleName)
+
+	var processBlockRuleToLookup uptycs.ProcessBlockRule
+
+	if len(processBlockRuleID) == 0 {
+		resp.Diagnostics.Append(nameAttr...)
+		processBlockRuleToLookup = uptycs.ProcessBlockRule{
+			Name: processBlockRuleName,
+		}
+	} else {
+		resp.Diagnostics.Append(idAttr...)
+		processBlockRuleToLookup = uptycs.ProcessBlockRule{
+			ID: processBlockRuleID,
+		}
+	}
+
+	processBlockRuleResp, err := d.client.GetProcessBlockRule(processBlockRuleToLookup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get processBlockRule with ID  "+processBlockRuleID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = ProcessBlockRule{
+		ID:          types.StringValue(processBlockRuleResp.ID),
+		Name:        types.StringValue(processBlockRuleResp.Name),
+		Description: types.StringValue(processBlockRuleResp.Description),
+		Enabled:     types.BoolValue(processBlockRuleResp.Enabled),
+	}
+
+	var rules []BlockRuleEntry
+	for _, _r := range processBlockRuleResp.Rules {
+		rules = append(rules, BlockRuleEntry{
+			Name:        types.StringValue(_r.Name),
+			Description: types.StringValue(_r.Description),
+			Rule:        types.StringValue(_r.Rule),
+		})
+	}
+	result.Rules = rules
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
@@ -0,0 +1,90 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func PrometheusTargetDataSource() datasource.DataSource {
+	return &prometheusTargetDataSource{}
+}
+
+type prometheusTargetDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *prometheusTargetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prometheus_target"
+}
+
+func (d *prometheusTargetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *prometheusTargetDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":              schema.StringAttribute{Optional: true},
+			"name":            schema.StringAttribute{Optional: true},
+			"description":     schema.StringAttribute{Optional: true},
+			"urls":            schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"scrape_interval": schema.Int64Attribute{Optional: true},
+			"labels":          schema.MapAttribute{ElementType: types.StringType, Optional: true},
+		},
+	}
+}
+
+func (d *prometheusTargetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var prometheusTargetID string
+	var prometheusTargetName string
+
+	idAttr := req.Config.GetAttribute(ctx, path.Root("id"), &prometheusTargetID)
+	nameAttr := req.Config.GetAttribute(ctx, path.Root("name"), &prometheusTargetName)
+
+	var prometheusTargetToLookup uptycs.PrometheusTarget
+
+	if len(prometheusTargetID) == 0 {
+		resp.Diagnostics.Append(nameAttr...)
+		prometheusTargetToLookup = uptycs.PrometheusTarget{
+			Name: prometheusTargetName,
+		}
+	} else {
+		resp.Diagnostics.Append(idAttr...)
+		prometheusTargetToLookup = uptycs.PrometheusTarget{
+			ID: prometheusTargetID,
+		}
+	}
+
+	prometheusTargetResp, err := d.client.GetPrometheusTarget(prometheusTargetToLookup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get prometheusTarget with ID  "+prometheusTargetID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = PrometheusTarget{
+		ID:             types.StringValue(prometheusTargetResp.ID),
+		Name:           types.StringValue(prometheusTargetResp.Name),
+		Description:    types.StringValue(prometheusTargetResp.Description),
+		URLs:           makeListStringAttribute(prometheusTargetResp.URLs),
+		ScrapeInterval: types.Int64Value(int64(prometheusTargetResp.ScrapeInterval)),
+		Labels:         makeMapStringAttribute(prometheusTargetResp.Labels),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
@@ -0,0 +1,179 @@
+package uptycs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"time"
+)
+
+const (
+	queryDefaultType    = "global"
+	queryDefaultTimeout = 60
+	queryDefaultMaxRows = 1000
+)
+
+func QueryDataSource() datasource.DataSource {
+	return &queryDataSource{}
+}
+
+type queryDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *queryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query"
+}
+
+func (d *queryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *queryDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{Required: true},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("global", "realtime"),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Seconds to wait for results before failing the read. A query that times out is not cancelled and keeps running on the tenant.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_rows": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rows": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *queryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config QueryJob
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	queryType := queryDefaultType
+	if !config.Type.IsNull() {
+		queryType = config.Type.ValueString()
+	}
+	timeout := int64(queryDefaultTimeout)
+	if !config.Timeout.IsNull() {
+		timeout = config.Timeout.ValueInt64()
+	}
+	maxRows := int64(queryDefaultMaxRows)
+	if !config.MaxRows.IsNull() {
+		maxRows = config.MaxRows.ValueInt64()
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	// RunQueryJob doesn't take a context, so it runs in its own goroutine and
+	// the read gives up when the timeout passes or terraform is interrupted.
+	// The query itself keeps running on the tenant until the API's own timeout
+	// ends it; the buffered channel lets the goroutine exit once it does.
+	type queryResult struct {
+		resp uptycs.QueryJobResult
+		err  error
+	}
+	done := make(chan queryResult, 1)
+	go func() {
+		queryResp, err := d.client.RunQueryJob(uptycs.QueryJob{
+			Query:   config.Query.ValueString(),
+			Type:    queryType,
+			Timeout: int(timeout),
+			MaxRows: int(maxRows),
+		})
+		done <- queryResult{queryResp, err}
+	}()
+
+	var queryResp uptycs.QueryJobResult
+	select {
+	case result := <-done:
+		if result.err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read.",
+				"Could not run query: "+result.err.Error(),
+			)
+			return
+		}
+		queryResp = result.resp
+	case <-queryCtx.Done():
+		if ctx.Err() != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read.",
+				"Query was cancelled: "+ctx.Err().Error(),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			fmt.Sprintf("Query did not complete within %d seconds", timeout),
+		)
+		return
+	}
+
+	rows := make([]attr.Value, 0)
+	for i, row := range queryResp.Items {
+		if int64(i) >= maxRows {
+			break
+		}
+		values := make(map[string]attr.Value, len(row))
+		for column, value := range row {
+			values[column] = types.StringValue(queryValueString(value))
+		}
+		rows = append(rows, types.MapValueMust(types.StringType, values))
+	}
+
+	config.Rows = types.ListValueMust(types.MapType{ElemType: types.StringType}, rows)
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func queryValueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(out)
+	}
+}
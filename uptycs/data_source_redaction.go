@@ -0,0 +1,106 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func RedactionDataSource() datasource.DataSource {
+	return &redactionDataSource{}
+}
+
+type redactionDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *redactionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redaction"
+}
+
+func (d *redactionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *redactionDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Optional: true},
+			"name":        schema.StringAttribute{Optional: true},
+			"description": schema.StringAttribute{Optional: true},
+			"enabled":     schema.BoolAttribute{Optional: true},
+			"rules": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"table_name":  schema.StringAttribute{Optional: true},
+						"column_name": schema.StringAttribute{Optional: true},
+						"regex":       schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *redactionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var redactionID string
+	var redactionName string
+
+	idAttr := req.Config.GetAttribute(ctx, path.Root("id"), &redactionID)
+	nameAttr := req.Config.GetAttribute(ctx, path.Root("name"), &redactionName)
+
+	var redactionToLookup uptycs.Redaction
+
+	if len(redactionID) == 0 {
+		resp.Diagnostics.Append(nameAttr...)
+		redactionToLookup = uptycs.Redaction{
+			Name: redactionName,
+		}
+	} else {
+		resp.Diagnostics.Append(idAttr...)
+		redactionToLookup = uptycs.Redaction{
+			ID: redactionID,
+		}
+	}
+
+	redactionResp, err := d.client.GetRedaction(redactionToLookup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get redaction with ID  "+redactionID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = Redaction{
+		ID:          types.StringValue(redactionResp.ID),
+		Name:        types.StringValue(redactionResp.Name),
+		Description: types.StringValue(redactionResp.Description),
+		Enabled:     types.BoolValue(redactionResp.Enabled),
+	}
+
+	var rules []RedactionEntry
+	for _, _r := range redactionResp.Rules {
+		rules = append(rules, RedactionEntry{
+			TableName:  types.StringValue(_r.TableName),
+			ColumnName: types.StringValue(_r.ColumnName),
+			Regex:      types.StringValue(_r.Regex),
+		})
+	}
+	result.Rules = rules
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
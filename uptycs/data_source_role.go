@@ -79,7 +79,7 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	var result = Role{
+	var result = RoleData{
 		ID:                   types.StringValue(roleResp.ID),
 		Name:                 types.StringValue(roleResp.Name),
 		Description:          types.StringValue(roleResp.Description),
@@ -0,0 +1,108 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func RolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+type rolesDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *rolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *rolesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Optional: true},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.StringAttribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+						"permissions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"hidden":                 schema.BoolAttribute{Computed: true},
+						"no_minimal_permissions": schema.BoolAttribute{Computed: true},
+						"role_object_groups": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Roles
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rolesResp, err := d.client.GetRoles()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get roles: "+err.Error(),
+		)
+		return
+	}
+
+	ids := make([]string, 0)
+	roles := make([]RolesItem, 0)
+	for _, r := range rolesResp.Items {
+		if !matchesStringFilter(config.Name, r.Name) {
+			continue
+		}
+
+		ids = append(ids, r.ID)
+		roles = append(roles, RolesItem{
+			ID:                   types.StringValue(r.ID),
+			Name:                 types.StringValue(r.Name),
+			Description:          types.StringValue(r.Description),
+			Permissions:          makeListStringAttribute(r.Permissions),
+			Hidden:               types.BoolValue(r.Hidden),
+			NoMinimalPermissions: types.BoolValue(r.NoMinimalPermissions),
+			RoleObjectGroups:     makeListStringAttributeFn(r.RoleObjectGroups, func(g uptycs.ObjectGroup) (string, bool) { return g.ObjectGroupID, true }),
+		})
+	}
+
+	config.IDs = makeListStringAttribute(ids)
+	config.Roles = roles
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
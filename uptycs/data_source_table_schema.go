@@ -0,0 +1,104 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func TableSchemaDataSource() datasource.DataSource {
+	return &tableSchemaDataSource{}
+}
+
+type tableSchemaDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *tableSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_table_schema"
+}
+
+func (d *tableSchemaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *tableSchemaDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Optional: true},
+			"tables": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":        schema.StringAttribute{Computed: true},
+						"description": schema.StringAttribute{Computed: true},
+						"columns": schema.ListNestedAttribute{
+							Computed: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name":        schema.StringAttribute{Computed: true},
+									"type":        schema.StringAttribute{Computed: true},
+									"description": schema.StringAttribute{Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tableSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TableSchema
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tablesResp, err := d.client.GetTables()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get table schemas: "+err.Error(),
+		)
+		return
+	}
+
+	tables := make([]TableSchemaTable, 0)
+	for _, t := range tablesResp.Items {
+		if !matchesStringFilter(config.Name, t.Name) {
+			continue
+		}
+
+		columns := make([]TableSchemaColumn, 0)
+		for _, c := range t.Columns {
+			columns = append(columns, TableSchemaColumn{
+				Name:        types.StringValue(c.Name),
+				Type:        types.StringValue(c.Type),
+				Description: types.StringValue(c.Description),
+			})
+		}
+		tables = append(tables, TableSchemaTable{
+			Name:        types.StringValue(t.Name),
+			Description: types.StringValue(t.Description),
+			Columns:     columns,
+		})
+	}
+
+	config.Tables = tables
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
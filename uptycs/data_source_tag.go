@@ -71,6 +71,34 @@ func (d *tagDataSource) Schema(_ context.Context, req datasource.SchemaRequest,
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"image_load_exclusions": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"audit_groups": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"destinations": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"redactions": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"audit_rules": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"prometheus_targets": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"atc_queries": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -131,6 +159,13 @@ func (d *tagDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		RegistryPaths:             makeListStringAttributeFn(tagResp.RegistryPaths, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
 		YaraGroupRules:            makeListStringAttributeFn(tagResp.YaraGroupRules, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
 		AuditConfigurations:       makeListStringAttributeFn(tagResp.AuditConfigurations, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
+		ImageLoadExclusions:       makeListStringAttributeFn(tagResp.ImageLoadExclusions, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
+		AuditGroups:               makeListStringAttributeFn(tagResp.AuditGroups, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
+		Destinations:              makeListStringAttributeFn(tagResp.Destinations, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
+		Redactions:                makeListStringAttributeFn(tagResp.Redactions, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
+		AuditRules:                makeListStringAttributeFn(tagResp.AuditRules, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
+		PrometheusTargets:         makeListStringAttributeFn(tagResp.PrometheusTargets, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
+		AtcQueries:                makeListStringAttributeFn(tagResp.AtcQueries, func(o uptycs.TagConfigurationObject) (string, bool) { return o.ID, true }),
 	}
 
 	diags := resp.State.Set(ctx, result)
@@ -77,7 +77,7 @@ func (d *tagRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		)
 		return
 	}
-	var result = TagRule{
+	var result = TagRuleData{
 		ID:             types.StringValue(tagRuleResp.ID),
 		Name:           types.StringValue(tagRuleResp.Name),
 		Description:    types.StringValue(tagRuleResp.Description),
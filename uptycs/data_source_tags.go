@@ -0,0 +1,141 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"path"
+)
+
+func TagsDataSource() datasource.DataSource {
+	return &tagsDataSource{}
+}
+
+type tagsDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *tagsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *tagsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *tagsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key":           schema.StringAttribute{Optional: true},
+			"value":         schema.StringAttribute{Optional: true},
+			"source":        schema.StringAttribute{Optional: true},
+			"resource_type": schema.StringAttribute{Optional: true},
+			"system":        schema.BoolAttribute{Optional: true},
+			"status":        schema.StringAttribute{Optional: true},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"tags": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                          schema.StringAttribute{Computed: true},
+						"key":                         schema.StringAttribute{Computed: true},
+						"value":                       schema.StringAttribute{Computed: true},
+						"source":                      schema.StringAttribute{Computed: true},
+						"resource_type":               schema.StringAttribute{Computed: true},
+						"system":                      schema.BoolAttribute{Computed: true},
+						"status":                      schema.StringAttribute{Computed: true},
+						"flag_profile":                schema.StringAttribute{Computed: true},
+						"custom_profile":              schema.StringAttribute{Computed: true},
+						"compliance_profile":          schema.StringAttribute{Computed: true},
+						"process_block_rule":          schema.StringAttribute{Computed: true},
+						"dns_block_rule":              schema.StringAttribute{Computed: true},
+						"windows_defender_preference": schema.StringAttribute{Computed: true},
+						"tag_rule":                    schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Tags
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Value.IsNull() {
+		if _, err := path.Match(config.Value.ValueString(), ""); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to read.",
+				"Invalid value glob "+config.Value.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tagsResp, err := d.client.GetTags()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get tags: "+err.Error(),
+		)
+		return
+	}
+
+	ids := make([]string, 0)
+	tags := make([]TagsItem, 0)
+	for _, t := range tagsResp.Items {
+		if !matchesStringFilter(config.Key, t.Key) ||
+			!matchesStringFilter(config.Source, t.Source) ||
+			!matchesStringFilter(config.ResourceType, t.ResourceType) ||
+			!matchesStringFilter(config.Status, t.Status) ||
+			!matchesBoolFilter(config.System, t.System) {
+			continue
+		}
+		if !config.Value.IsNull() {
+			if matched, _ := path.Match(config.Value.ValueString(), t.Value); !matched {
+				continue
+			}
+		}
+
+		ids = append(ids, t.ID)
+		tags = append(tags, TagsItem{
+			ID:                        types.StringValue(t.ID),
+			Key:                       types.StringValue(t.Key),
+			Value:                     types.StringValue(t.Value),
+			Source:                    types.StringValue(t.Source),
+			ResourceType:              types.StringValue(t.ResourceType),
+			System:                    types.BoolValue(t.System),
+			Status:                    types.StringValue(t.Status),
+			FlagProfile:               types.StringValue(t.FlagProfileID),
+			CustomProfile:             types.StringValue(t.CustomProfileID),
+			ComplianceProfile:         types.StringValue(t.ComplianceProfileID),
+			ProcessBlockRule:          types.StringValue(t.ProcessBlockRuleID),
+			DNSBlockRule:              types.StringValue(t.DNSBlockRuleID),
+			WindowsDefenderPreference: types.StringValue(t.WindowsDefenderPreferenceID),
+			TagRule:                   types.StringValue(t.TagRuleID),
+		})
+	}
+
+	config.IDs = makeListStringAttribute(ids)
+	config.Tags = tags
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
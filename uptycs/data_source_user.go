@@ -88,7 +88,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	var result = User{
+	var result = UserData{
 		ID:                 types.StringValue(userResp.ID),
 		Name:               types.StringValue(userResp.Name),
 		Email:              types.StringValue(userResp.Email),
@@ -0,0 +1,142 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func UsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *usersDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{Optional: true},
+			"bot":    schema.BoolAttribute{Optional: true},
+			"role":   schema.StringAttribute{Optional: true},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                 schema.StringAttribute{Computed: true},
+						"name":               schema.StringAttribute{Computed: true},
+						"email":              schema.StringAttribute{Computed: true},
+						"phone":              schema.StringAttribute{Computed: true},
+						"active":             schema.BoolAttribute{Computed: true},
+						"super_admin":        schema.BoolAttribute{Computed: true},
+						"bot":                schema.BoolAttribute{Computed: true},
+						"support":            schema.BoolAttribute{Computed: true},
+						"image_url":          schema.StringAttribute{Computed: true},
+						"max_idle_time_mins": schema.Int64Attribute{Computed: true},
+						"alert_hidden_columns": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"roles": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"role_names": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"user_object_groups": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Users
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usersResp, err := d.client.GetUsers()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get users: "+err.Error(),
+		)
+		return
+	}
+
+	ids := make([]string, 0)
+	users := make([]UsersItem, 0)
+	for _, u := range usersResp.Items {
+		if !matchesBoolFilter(config.Active, u.Active) || !matchesBoolFilter(config.Bot, u.Bot) {
+			continue
+		}
+		if !config.Role.IsNull() {
+			hasRole := false
+			for _, r := range u.Roles {
+				if r.Name == config.Role.ValueString() {
+					hasRole = true
+					break
+				}
+			}
+			if !hasRole {
+				continue
+			}
+		}
+
+		ids = append(ids, u.ID)
+		users = append(users, UsersItem{
+			ID:                 types.StringValue(u.ID),
+			Name:               types.StringValue(u.Name),
+			Email:              types.StringValue(u.Email),
+			Phone:              types.StringValue(u.Phone),
+			Active:             types.BoolValue(u.Active),
+			SuperAdmin:         types.BoolValue(u.SuperAdmin),
+			Bot:                types.BoolValue(u.Bot),
+			Support:            types.BoolValue(u.Support),
+			ImageURL:           types.StringValue(u.ImageURL),
+			MaxIdleTimeMins:    types.Int64Value(int64(u.MaxIdleTimeMins)),
+			AlertHiddenColumns: makeListStringAttribute(u.AlertHiddenColumns),
+			Roles:              makeListStringAttributeFn(u.Roles, func(g uptycs.Role) (string, bool) { return g.ID, true }),
+			RoleNames:          makeListStringAttributeFn(u.Roles, func(g uptycs.Role) (string, bool) { return g.Name, true }),
+			UserObjectGroups:   makeListStringAttributeFn(u.UserObjectGroups, func(g uptycs.ObjectGroup) (string, bool) { return g.ObjectGroupID, true }),
+		})
+	}
+
+	config.IDs = makeListStringAttribute(ids)
+	config.Users = users
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
@@ -0,0 +1,108 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func WindowsDefenderPreferenceDataSource() datasource.DataSource {
+	return &windowsDefenderPreferenceDataSource{}
+}
+
+type windowsDefenderPreferenceDataSource struct {
+	client *uptycs.Client
+}
+
+func (d *windowsDefenderPreferenceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_windows_defender_preference"
+}
+
+func (d *windowsDefenderPreferenceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*uptycs.Client)
+}
+
+func (d *windowsDefenderPreferenceDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Optional: true},
+			"name":        schema.StringAttribute{Optional: true},
+			"description": schema.StringAttribute{Optional: true},
+			"preferences": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"disable_realtime_monitoring":      schema.BoolAttribute{Optional: true},
+					"disable_behavior_monitoring":      schema.BoolAttribute{Optional: true},
+					"disable_ioav_protection":          schema.BoolAttribute{Optional: true},
+					"disable_script_scanning":          schema.BoolAttribute{Optional: true},
+					"disable_archive_scanning":         schema.BoolAttribute{Optional: true},
+					"disable_removable_drive_scanning": schema.BoolAttribute{Optional: true},
+					"disable_email_scanning":           schema.BoolAttribute{Optional: true},
+					"pua_protection":                   schema.StringAttribute{Optional: true},
+					"cloud_block_level":                schema.StringAttribute{Optional: true},
+					"maps_reporting":                   schema.StringAttribute{Optional: true},
+					"submit_samples_consent":           schema.StringAttribute{Optional: true},
+					"enable_network_protection":        schema.StringAttribute{Optional: true},
+					"enable_controlled_folder_access":  schema.StringAttribute{Optional: true},
+					"cloud_extended_timeout":           schema.Int64Attribute{Optional: true},
+					"signature_update_interval":        schema.Int64Attribute{Optional: true},
+					"exclusion_paths":                  schema.ListAttribute{ElementType: types.StringType, Optional: true},
+					"exclusion_extensions":             schema.ListAttribute{ElementType: types.StringType, Optional: true},
+					"exclusion_processes":              schema.ListAttribute{ElementType: types.StringType, Optional: true},
+				},
+			},
+		},
+	}
+}
+
+func (d *windowsDefenderPreferenceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var windowsDefenderPreferenceID string
+	var windowsDefenderPreferenceName string
+
+	idAttr := req.Config.GetAttribute(ctx, path.Root("id"), &windowsDefenderPreferenceID)
+	nameAttr := req.Config.GetAttribute(ctx, path.Root("name"), &windowsDefenderPreferenceName)
+
+	var windowsDefenderPreferenceToLookup uptycs.WindowsDefenderPreference
+
+	if len(windowsDefenderPreferenceID) == 0 {
+		resp.Diagnostics.Append(nameAttr...)
+		windowsDefenderPreferenceToLookup = uptycs.WindowsDefenderPreference{
+			Name: windowsDefenderPreferenceName,
+		}
+	} else {
+		resp.Diagnostics.Append(idAttr...)
+		windowsDefenderPreferenceToLookup = uptycs.WindowsDefenderPreference{
+			ID: windowsDefenderPreferenceID,
+		}
+	}
+
+	windowsDefenderPreferenceResp, err := d.client.GetWindowsDefenderPreference(windowsDefenderPreferenceToLookup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to read.",
+			"Could not get windowsDefenderPreference with ID  "+windowsDefenderPreferenceID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = WindowsDefenderPreference{
+		ID:          types.StringValue(windowsDefenderPreferenceResp.ID),
+		Name:        types.StringValue(windowsDefenderPreferenceResp.Name),
+		Description: types.StringValue(windowsDefenderPreferenceResp.Description),
+		Preferences: makeWindowsDefenderPreferences(windowsDefenderPreferenceResp.Preferences),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
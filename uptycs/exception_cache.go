@@ -0,0 +1,81 @@
+package uptycs
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+// exceptionCache is shared by every resource of a provider instance. The
+// alert and event rule resources look up each attached exception to tell
+// global exceptions apart; the cache loads all exceptions with one list call
+// and only falls back to GetException for ones created later in the run.
+// Neither call is made while holding mu, which only guards the map.
+type exceptionCache struct {
+	list       func() ([]uptycs.Exception, error)
+	fetch      func(exceptionID string) (uptycs.Exception, error)
+	load       sync.Once
+	mu         sync.Mutex
+	exceptions map[string]uptycs.Exception
+}
+
+func newExceptionCache(client *uptycs.Client) *exceptionCache {
+	return &exceptionCache{
+		list: func() ([]uptycs.Exception, error) {
+			exceptionsResp, err := client.GetExceptions()
+			return exceptionsResp.Items, err
+		},
+		fetch: func(exceptionID string) (uptycs.Exception, error) {
+			return client.GetException(uptycs.Exception{
+				ID: exceptionID,
+			})
+		},
+		exceptions: make(map[string]uptycs.Exception),
+	}
+}
+
+func (c *exceptionCache) get(ctx context.Context, exceptionID string) (uptycs.Exception, error) {
+	c.load.Do(func() {
+		exceptions, err := c.list()
+		if err != nil {
+			tflog.Warn(ctx, "Could not list exceptions, fetching them one at a time", map[string]any{"error": err.Error()})
+			return
+		}
+		c.mu.Lock()
+		for _, e := range exceptions {
+			c.exceptions[e.ID] = e
+		}
+		c.mu.Unlock()
+		tflog.Debug(ctx, "Loaded exception cache", map[string]any{"count": len(exceptions)})
+	})
+
+	c.mu.Lock()
+	e, ok := c.exceptions[exceptionID]
+	c.mu.Unlock()
+	if ok {
+		tflog.Debug(ctx, "Exception cache hit", map[string]any{"id": exceptionID})
+		return e, nil
+	}
+
+	tflog.Debug(ctx, "Exception cache miss", map[string]any{"id": exceptionID})
+	exceptionResp, err := c.fetch(exceptionID)
+	if err != nil {
+		return exceptionResp, err
+	}
+	c.set(exceptionResp)
+	return exceptionResp, nil
+}
+
+func (c *exceptionCache) set(exception uptycs.Exception) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.exceptions[exception.ID] = exception
+}
+
+func (c *exceptionCache) remove(exceptionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.exceptions, exceptionID)
+}
@@ -0,0 +1,124 @@
+package uptycs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func testExceptionCache(list func() ([]uptycs.Exception, error), fetch func(string) (uptycs.Exception, error)) *exceptionCache {
+	return &exceptionCache{
+		list:       list,
+		fetch:      fetch,
+		exceptions: make(map[string]uptycs.Exception),
+	}
+}
+
+func TestExceptionCacheListsOnce(t *testing.T) {
+	var lists, fetches int32
+	c := testExceptionCache(
+		func() ([]uptycs.Exception, error) {
+			atomic.AddInt32(&lists, 1)
+			return []uptycs.Exception{{ID: "a", IsGlobal: true}, {ID: "b"}}, nil
+		},
+		func(exceptionID string) (uptycs.Exception, error) {
+			atomic.AddInt32(&fetches, 1)
+			return uptycs.Exception{ID: exceptionID}, nil
+		},
+	)
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.get(ctx, "a"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	e, err := c.get(ctx, "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.IsGlobal {
+		t.Errorf("exception a should be global")
+	}
+	if lists != 1 || fetches != 0 {
+		t.Errorf("lists = %d, fetches = %d, want 1 and 0", lists, fetches)
+	}
+}
+
+func TestExceptionCacheFetchesMisses(t *testing.T) {
+	var fetches int32
+	c := testExceptionCache(
+		func() ([]uptycs.Exception, error) {
+			return nil, errors.New("forbidden")
+		},
+		func(exceptionID string) (uptycs.Exception, error) {
+			atomic.AddInt32(&fetches, 1)
+			if exceptionID == "missing" {
+				return uptycs.Exception{}, errors.New("not found")
+			}
+			return uptycs.Exception{ID: exceptionID}, nil
+		},
+	)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := c.get(ctx, "c"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if fetches != 1 {
+		t.Errorf("fetches = %d, want 1", fetches)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.get(ctx, "missing"); err == nil {
+			t.Fatal("expected an error for a missing exception")
+		}
+	}
+	if fetches != 3 {
+		t.Errorf("fetches = %d, want errors not to be cached", fetches)
+	}
+}
+
+func TestExceptionCacheSetAndRemove(t *testing.T) {
+	var fetches int32
+	c := testExceptionCache(
+		func() ([]uptycs.Exception, error) {
+			return nil, nil
+		},
+		func(exceptionID string) (uptycs.Exception, error) {
+			atomic.AddInt32(&fetches, 1)
+			return uptycs.Exception{ID: exceptionID}, nil
+		},
+	)
+
+	ctx := context.Background()
+	c.set(uptycs.Exception{ID: "d", IsGlobal: true})
+	e, err := c.get(ctx, "d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !e.IsGlobal || fetches != 0 {
+		t.Errorf("got %+v after %d fetches, want the cached global exception", e, fetches)
+	}
+
+	c.remove("d")
+	e, err = c.get(ctx, "d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.IsGlobal || fetches != 1 {
+		t.Errorf("got %+v after %d fetches, want a fresh fetch", e, fetches)
+	}
+}
@@ -0,0 +1,485 @@
+package uptycs
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"golang.org/x/exp/slices"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ExportConfig holds the settings for the export subcommand.
+type ExportConfig struct {
+	Host       string
+	APIKey     string
+	APISecret  string
+	CustomerID string
+	Dir        string
+	Types      []string
+	// NamePrefix, DefaultAlertTags and DefaultEventTags mirror the provider
+	// settings of the same name, so the generated configuration round-trips.
+	NamePrefix       string
+	DefaultAlertTags []string
+	DefaultEventTags []string
+}
+
+type exportObject struct {
+	ID   string
+	Name string
+	// System objects are shipped by Uptycs, and the provider refuses to change
+	// them unless allow_system_object_changes is set, so they aren't exported.
+	System bool
+}
+
+type exportType struct {
+	TypeName string
+	Resource func() resource.Resource
+	List     func(*uptycs.Client) ([]exportObject, error)
+}
+
+func exportItems[T any](items []T, fn func(T) exportObject) []exportObject {
+	objects := make([]exportObject, 0, len(items))
+	for _, i := range items {
+		objects = append(objects, fn(i))
+	}
+	return objects
+}
+
+var exportTypes = []exportType{
+	{"uptycs_alert_rule", AlertRuleResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetAlertRules()
+		return exportItems(resp.Items, func(o uptycs.AlertRule) exportObject {
+			return exportObject{ID: o.ID, Name: o.Name, System: o.IsInternal}
+		}), err
+	}},
+	{"uptycs_audit_group", AuditGroupResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetAuditGroups()
+		return exportItems(resp.Items, func(o uptycs.AuditGroup) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_audit_rule", AuditRuleResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetAuditRules()
+		return exportItems(resp.Items, func(o uptycs.AuditRule) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_compliance_profile", ComplianceProfileResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetComplianceProfiles()
+		return exportItems(resp.Items, func(o uptycs.ComplianceProfile) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_custom_profile", CustomProfileResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetCustomProfiles()
+		return exportItems(resp.Items, func(o uptycs.CustomProfile) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_destination", DestinationResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetDestinations()
+		return exportItems(resp.Items, func(o uptycs.Destination) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_dns_block_rule", DNSBlockRuleResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetDNSBlockRules()
+		return exportItems(resp.Items, func(o uptycs.DNSBlockRule) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_event_exclude_profile", EventExcludeProfileResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetEventExcludeProfiles()
+		return exportItems(resp.Items, func(o uptycs.EventExcludeProfile) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_event_rule", EventRuleResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetEventRules()
+		return exportItems(resp.Items, func(o uptycs.EventRule) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_exception", ExceptionResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetExceptions()
+		return exportItems(resp.Items, func(o uptycs.Exception) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_file_path_group", FilePathGroupResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetFilePathGroups()
+		return exportItems(resp.Items, func(o uptycs.FilePathGroup) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_flag_profile", FlagProfileResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetFlagProfiles()
+		return exportItems(resp.Items, func(o uptycs.FlagProfile) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_image_load_exclusion", ImageLoadExclusionResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetImageLoadExclusions()
+		return exportItems(resp.Items, func(o uptycs.ImageLoadExclusion) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_lookup_table", LookupTableResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetLookupTables()
+		return exportItems(resp.Items, func(o uptycs.LookupTable) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_process_block_rule", ProcessBlockRuleResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetProcessBlockRules()
+		return exportItems(resp.Items, func(o uptycs.ProcessBlockRule) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_prometheus_target", PrometheusTargetResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetPrometheusTargets()
+		return exportItems(resp.Items, func(o uptycs.PrometheusTarget) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_querypack", QuerypackResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetQuerypacks()
+		return exportItems(resp.Items, func(o uptycs.Querypack) exportObject {
+			return exportObject{ID: o.ID, Name: o.Name, System: o.IsInternal}
+		}), err
+	}},
+	{"uptycs_redaction", RedactionResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetRedactions()
+		return exportItems(resp.Items, func(o uptycs.Redaction) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_registry_path", RegistryPathResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetRegistryPaths()
+		return exportItems(resp.Items, func(o uptycs.RegistryPath) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_role", RoleResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetRoles()
+		return exportItems(resp.Items, func(o uptycs.Role) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_tag", TagResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetTags()
+		return exportItems(resp.Items, func(o uptycs.Tag) exportObject {
+			return exportObject{ID: o.ID, Name: o.Key + "_" + o.Value, System: o.System}
+		}), err
+	}},
+	{"uptycs_tag_rule", TagRuleResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetTagRules()
+		return exportItems(resp.Items, func(o uptycs.TagRule) exportObject { return exportObject{ID: o.ID, Name: o.Name, System: o.System} }), err
+	}},
+	{"uptycs_user", UserResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetUsers()
+		return exportItems(resp.Items, func(o uptycs.User) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_windows_defender_preference", WindowsDefenderPreferenceResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetWindowsDefenderPreferences()
+		return exportItems(resp.Items, func(o uptycs.WindowsDefenderPreference) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+	{"uptycs_yara_group_rule", YaraGroupRuleResource, func(c *uptycs.Client) ([]exportObject, error) {
+		resp, err := c.GetYaraGroupRules()
+		return exportItems(resp.Items, func(o uptycs.YaraGroupRule) exportObject { return exportObject{ID: o.ID, Name: o.Name} }), err
+	}},
+}
+
+// Export writes one <type>.tf file per resource type into cfg.Dir, holding a
+// resource block and an import block for every object in the tenant. Objects
+// are read through the resources' own Read methods so the generated
+// configuration matches what a plan would produce.
+func Export(ctx context.Context, cfg ExportConfig) error {
+	client, err := uptycs.NewClient(uptycs.Config{
+		Host:       cfg.Host,
+		APIKey:     cfg.APIKey,
+		APISecret:  cfg.APISecret,
+		CustomerID: cfg.CustomerID,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to create uptycs client: %w", err)
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return err
+	}
+
+	data := &resourceData{
+		client:       client,
+		exceptions:   newExceptionCache(client),
+		permissions:  newPermissionCache(client),
+		tableSchemas: newTableSchemaCache(client),
+		settings: providerSettings{
+			namePrefix:       cfg.NamePrefix,
+			defaultAlertTags: cfg.DefaultAlertTags,
+			defaultEventTags: cfg.DefaultEventTags,
+		},
+	}
+
+	// A type that can't be listed, or an object that can't be read, is
+	// reported and skipped so the rest of the tenant is still exported
+	failed := make([]string, 0)
+	for _, et := range exportTypes {
+		if len(cfg.Types) > 0 && !slices.Contains(cfg.Types, et.TypeName) {
+			continue
+		}
+
+		objects, err := et.List(client)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping %s: could not list: %v\n", et.TypeName, err)
+			failed = append(failed, et.TypeName)
+			continue
+		}
+		if len(objects) == 0 {
+			continue
+		}
+
+		r := et.Resource()
+		if rc, ok := r.(resource.ResourceWithConfigure); ok {
+			rc.Configure(ctx, resource.ConfigureRequest{ProviderData: data}, &resource.ConfigureResponse{})
+		}
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+		var b strings.Builder
+		names := make(map[string]bool)
+		exported := 0
+		for _, o := range objects {
+			if o.System {
+				fmt.Fprintf(os.Stderr, "Skipping %s %s: it is shipped by Uptycs\n", et.TypeName, o.ID)
+				continue
+			}
+
+			state, err := exportReadState(ctx, r, schemaResp.Schema, o.ID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Skipping %s %s: could not read: %v\n", et.TypeName, o.ID, err)
+				failed = append(failed, et.TypeName+" "+o.ID)
+				continue
+			}
+
+			name := exportResourceName(strings.TrimPrefix(o.Name, cfg.NamePrefix), names)
+			var body strings.Builder
+			variables := make([]string, 0)
+			if err := exportAttributes(&body, "  ", et.TypeName+"_"+name, schemaResp.Schema.Attributes, state, &variables); err != nil {
+				fmt.Fprintf(os.Stderr, "Skipping %s %s: could not render: %v\n", et.TypeName, o.ID, err)
+				failed = append(failed, et.TypeName+" "+o.ID)
+				continue
+			}
+
+			for _, v := range variables {
+				b.WriteString(fmt.Sprintf("variable %q {\n  sensitive = true\n}\n\n", v))
+			}
+			b.WriteString(fmt.Sprintf("import {\n  to = %s.%s\n  id = %s\n}\n\n", et.TypeName, name, exportString(o.ID)))
+			b.WriteString(fmt.Sprintf("resource %q %q {\n", et.TypeName, name))
+			b.WriteString(body.String())
+			b.WriteString("}\n\n")
+			exported++
+		}
+		if exported == 0 {
+			continue
+		}
+
+		file := filepath.Join(cfg.Dir, strings.TrimPrefix(et.TypeName, "uptycs_")+".tf")
+		if err := os.WriteFile(file, []byte(strings.TrimSuffix(b.String(), "\n")), 0644); err != nil {
+			return err
+		}
+		fmt.Printf("Exported %d %s to %s\n", exported, et.TypeName, file)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("could not export %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func exportReadState(ctx context.Context, r resource.Resource, s schema.Schema, id string) (tftypes.Value, error) {
+	state, diags := readResourceState(ctx, r, tfsdk.State{Schema: s}, id)
+	if diags.HasError() {
+		return tftypes.Value{}, fmt.Errorf("%s", diags.Errors()[0].Detail())
+	}
+	return state.Raw, nil
+}
+
+var exportNameRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// exportSkippedAttributes only change how the provider manages an object, so
+// exporting them would just repeat their defaults.
+var exportSkippedAttributes = map[string]bool{
+	"deletion_protection": true,
+	"on_destroy":          true,
+}
+
+func exportResourceName(name string, used map[string]bool) string {
+	base := strings.Trim(exportNameRegex.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "r_" + base
+	}
+	result := base
+	for i := 2; used[result]; i++ {
+		result = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[result] = true
+	return result
+}
+
+// exportAttributes renders attributes as HCL. Sensitive values are never
+// written out: required ones are replaced by a reference to a variable named
+// after prefix, which is appended to variables, and optional ones are left out.
+func exportAttributes(b *strings.Builder, indent string, prefix string, attributes map[string]schema.Attribute, value tftypes.Value, variables *[]string) error {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		a := attributes[k]
+		v := values[k]
+		// The id is carried by the import block
+		if k == "id" || exportSkippedAttributes[k] || (a.IsComputed() && !a.IsOptional() && !a.IsRequired()) || v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		if a.IsSensitive() {
+			if a.IsRequired() {
+				variable := prefix + "_" + k
+				*variables = append(*variables, variable)
+				b.WriteString(fmt.Sprintf("%s%s = var.%s\n", indent, k, variable))
+			} else {
+				b.WriteString(fmt.Sprintf("%s# %s is sensitive and was not exported\n", indent, k))
+			}
+			continue
+		}
+
+		switch na := a.(type) {
+		case schema.SingleNestedAttribute:
+			b.WriteString(fmt.Sprintf("%s%s = {\n", indent, k))
+			if err := exportAttributes(b, indent+"  ", prefix+"_"+k, na.Attributes, v, variables); err != nil {
+				return err
+			}
+			b.WriteString(indent + "}\n")
+		case schema.ListNestedAttribute:
+			var elems []tftypes.Value
+			if err := v.As(&elems); err != nil {
+				return err
+			}
+			if len(elems) == 0 {
+				b.WriteString(fmt.Sprintf("%s%s = []\n", indent, k))
+				continue
+			}
+			b.WriteString(fmt.Sprintf("%s%s = [\n", indent, k))
+			for i, e := range elems {
+				b.WriteString(indent + "  {\n")
+				if err := exportAttributes(b, indent+"    ", fmt.Sprintf("%s_%s_%d", prefix, k, i), na.NestedObject.Attributes, e, variables); err != nil {
+					return err
+				}
+				b.WriteString(indent + "  },\n")
+			}
+			b.WriteString(indent + "]\n")
+		default:
+			rendered, err := exportValue(v, indent)
+			if err != nil {
+				return err
+			}
+			b.WriteString(fmt.Sprintf("%s%s = %s\n", indent, k, rendered))
+		}
+	}
+	return nil
+}
+
+func exportValue(v tftypes.Value, indent string) (string, error) {
+	if v.IsNull() {
+		return "null", nil
+	}
+
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return "", err
+		}
+		return exportString(s), nil
+	case v.Type().Is(tftypes.Bool):
+		var bv bool
+		if err := v.As(&bv); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%t", bv), nil
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return "", err
+		}
+		return n.Text('f', -1), nil
+	case v.Type().Is(tftypes.List{}), v.Type().Is(tftypes.Set{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return "", err
+		}
+		if len(elems) == 0 {
+			return "[]", nil
+		}
+		var b strings.Builder
+		b.WriteString("[\n")
+		for _, e := range elems {
+			rendered, err := exportValue(e, indent+"  ")
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(indent + "  " + rendered + ",\n")
+		}
+		b.WriteString(indent + "]")
+		return b.String(), nil
+	case v.Type().Is(tftypes.Map{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return "", err
+		}
+		if len(elems) == 0 {
+			return "{}", nil
+		}
+		keys := make([]string, 0, len(elems))
+		for k := range elems {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, k := range keys {
+			rendered, err := exportValue(elems[k], indent+"  ")
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(fmt.Sprintf("%s  %s = %s\n", indent, exportString(k), rendered))
+		}
+		b.WriteString(indent + "}")
+		return b.String(), nil
+	case v.Type().Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+		if err := v.As(&attributes); err != nil {
+			return "", err
+		}
+		keys := make([]string, 0, len(attributes))
+		for k := range attributes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, k := range keys {
+			if attributes[k].IsNull() {
+				continue
+			}
+			rendered, err := exportValue(attributes[k], indent+"  ")
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(fmt.Sprintf("%s  %s = %s\n", indent, k, rendered))
+		}
+		b.WriteString(indent + "}")
+		return b.String(), nil
+	}
+
+	// Fail rather than leave the attribute out of the generated configuration
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// exportString renders s as an HCL string. Multi-line values that end in a
+// newline, such as JSON documents read back from the API, become heredocs.
+func exportString(s string) string {
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(s)
+	if strings.HasSuffix(s, "\n") && strings.Count(s, "\n") > 1 && !strings.HasPrefix(s, "EOT\n") && !strings.Contains(s, "\nEOT\n") {
+		return "<<EOT\n" + escaped + "EOT"
+	}
+
+	quoted := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	).Replace(escaped)
+	return `"` + quoted + `"`
+}
@@ -0,0 +1,234 @@
+package uptycs
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExportString(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "hello", want: `"hello"`},
+		{name: "escapes", in: "a \"quoted\" \\ path\twith\ttabs", want: `"a \"quoted\" \\ path\twith\ttabs"`},
+		{name: "single line with newline", in: "select 1\n", want: `"select 1\n"`},
+		{name: "template sequences", in: "${var} and %{if}", want: `"$${var} and %%{if}"`},
+		{name: "heredoc", in: "{\n  \"a\": 1\n}\n", want: "<<EOT\n{\n  \"a\": 1\n}\nEOT"},
+		{name: "heredoc keeps backslashes", in: "c:\\temp\nd:\\temp\n", want: "<<EOT\nc:\\temp\nd:\\temp\nEOT"},
+		{name: "heredoc escapes templates", in: "${a}\n${b}\n", want: "<<EOT\n$${a}\n$${b}\nEOT"},
+		{name: "no heredoc without trailing newline", in: "a\nb", want: `"a\nb"`},
+		{name: "no heredoc containing the marker", in: "a\nEOT\nb\n", want: `"a\nEOT\nb\n"`},
+		{name: "no heredoc starting with the marker", in: "EOT\nb\n", want: `"EOT\nb\n"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exportString(tt.in); got != tt.want {
+				t.Errorf("exportString(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportValue(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":    tftypes.String,
+		"enabled": tftypes.Bool,
+		"unset":   tftypes.String,
+	}}
+
+	tests := []struct {
+		name    string
+		value   tftypes.Value
+		want    string
+		wantErr bool
+	}{
+		{name: "null", value: tftypes.NewValue(tftypes.String, nil), want: "null"},
+		{name: "string", value: tftypes.NewValue(tftypes.String, "x"), want: `"x"`},
+		{name: "bool", value: tftypes.NewValue(tftypes.Bool, true), want: "true"},
+		{name: "integer", value: tftypes.NewValue(tftypes.Number, big.NewFloat(3600)), want: "3600"},
+		{name: "float", value: tftypes.NewValue(tftypes.Number, big.NewFloat(0.5)), want: "0.5"},
+		{name: "empty list", value: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}), want: "[]"},
+		{
+			name: "list",
+			value: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			}),
+			want: "[\n    \"a\",\n    \"b\",\n  ]",
+		},
+		{
+			name: "set",
+			value: tftypes.NewValue(tftypes.Set{ElementType: tftypes.Bool}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Bool, false),
+			}),
+			want: "[\n    false,\n  ]",
+		},
+		{
+			name: "map",
+			value: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"b":     tftypes.NewValue(tftypes.String, "2"),
+				"a key": tftypes.NewValue(tftypes.String, "1"),
+			}),
+			want: "{\n    \"a key\" = \"1\"\n    \"b\" = \"2\"\n  }",
+		},
+		{
+			name: "object",
+			value: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"name":    tftypes.NewValue(tftypes.String, "n"),
+				"enabled": tftypes.NewValue(tftypes.Bool, true),
+				"unset":   tftypes.NewValue(tftypes.String, nil),
+			}),
+			want: "{\n    enabled = true\n    name = \"n\"\n  }",
+		},
+		{
+			name: "list of objects",
+			value: tftypes.NewValue(tftypes.List{ElementType: objectType}, []tftypes.Value{
+				tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"name":    tftypes.NewValue(tftypes.String, "n"),
+					"enabled": tftypes.NewValue(tftypes.Bool, false),
+					"unset":   tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+			want: "[\n    {\n      enabled = false\n      name = \"n\"\n    },\n  ]",
+		},
+		{
+			name:    "unsupported",
+			value:   tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}}, []tftypes.Value{tftypes.NewValue(tftypes.String, "a")}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := exportValue(tt.value, "  ")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportResourceName(t *testing.T) {
+	used := make(map[string]bool)
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "My Rule", want: "my_rule"},
+		{in: "My  Rule!", want: "my_rule_2"},
+		{in: "my-rule", want: "my_rule_3"},
+		{in: "  Trimmed -- ", want: "trimmed"},
+		{in: "1st rule", want: "r_1st_rule"},
+		{in: "", want: "r_"},
+		{in: "!!!", want: "r__2"},
+		{in: "Ünïcode", want: "n_code"},
+	}
+
+	for _, tt := range tests {
+		if got := exportResourceName(tt.in, used); got != tt.want {
+			t.Errorf("exportResourceName(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestExportAttributes(t *testing.T) {
+	attributes := map[string]schema.Attribute{
+		"id":                  schema.StringAttribute{Computed: true},
+		"name":                schema.StringAttribute{Required: true},
+		"created_at":          schema.StringAttribute{Computed: true},
+		"description":         schema.StringAttribute{Optional: true},
+		"tags":                schema.ListAttribute{Optional: true, ElementType: types.StringType},
+		"password":            schema.StringAttribute{Required: true, Sensitive: true},
+		"token":               schema.StringAttribute{Optional: true, Sensitive: true},
+		"deletion_protection": schema.BoolAttribute{Optional: true},
+		"on_destroy":          schema.StringAttribute{Optional: true},
+		"sql_config": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"interval_seconds": schema.Int64Attribute{Optional: true},
+			},
+		},
+		"destinations": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"destination_id": schema.StringAttribute{Optional: true},
+					"secret":         schema.StringAttribute{Required: true, Sensitive: true},
+				},
+			},
+		},
+	}
+	s := schema.Schema{Attributes: attributes}
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	sqlConfigType := objectType.AttributeTypes["sql_config"]
+	destinationsType := objectType.AttributeTypes["destinations"].(tftypes.List)
+
+	value := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, "11111111-2222-3333-4444-555555555555"),
+		"name":                tftypes.NewValue(tftypes.String, "My Rule"),
+		"created_at":          tftypes.NewValue(tftypes.String, "yesterday"),
+		"description":         tftypes.NewValue(tftypes.String, nil),
+		"tags":                tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "a")}),
+		"password":            tftypes.NewValue(tftypes.String, "hunter2"),
+		"token":               tftypes.NewValue(tftypes.String, "t0ken"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, true),
+		"on_destroy":          tftypes.NewValue(tftypes.String, "disable"),
+		"sql_config": tftypes.NewValue(sqlConfigType, map[string]tftypes.Value{
+			"interval_seconds": tftypes.NewValue(tftypes.Number, big.NewFloat(3600)),
+		}),
+		"destinations": tftypes.NewValue(destinationsType, []tftypes.Value{
+			tftypes.NewValue(destinationsType.ElementType, map[string]tftypes.Value{
+				"destination_id": tftypes.NewValue(tftypes.String, "d1"),
+				"secret":         tftypes.NewValue(tftypes.String, "s3cret"),
+			}),
+		}),
+	})
+
+	var b strings.Builder
+	variables := make([]string, 0)
+	if err := exportAttributes(&b, "  ", "uptycs_alert_rule_my_rule", attributes, value, &variables); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `  destinations = [
+    {
+      destination_id = "d1"
+      secret = var.uptycs_alert_rule_my_rule_destinations_0_secret
+    },
+  ]
+  name = "My Rule"
+  password = var.uptycs_alert_rule_my_rule_password
+  sql_config = {
+    interval_seconds = 3600
+  }
+  tags = [
+    "a",
+  ]
+  # token is sensitive and was not exported
+`
+	if got := b.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	wantVariables := []string{"uptycs_alert_rule_my_rule_destinations_0_secret", "uptycs_alert_rule_my_rule_password"}
+	if !reflect.DeepEqual(variables, wantVariables) {
+		t.Errorf("variables = %v, want %v", variables, wantVariables)
+	}
+	for _, secret := range []string{"hunter2", "t0ken", "s3cret"} {
+		if strings.Contains(b.String(), secret) {
+			t.Errorf("output contains the sensitive value %q", secret)
+		}
+	}
+}
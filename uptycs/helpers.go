@@ -31,6 +31,28 @@ func makeListStringAttributeFn[T any](in []T, fn func(T) (string, bool)) types.L
 	return types.ListValueMust(types.StringType, values)
 }
 
+func makeMapStringAttribute(in map[string]string) types.Map {
+	values := make(map[string]attr.Value, len(in))
+	for k, v := range in {
+		values[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+func matchesStringFilter(filter types.String, value string) bool {
+	if filter.IsNull() || filter.IsUnknown() {
+		return true
+	}
+	return filter.ValueString() == value
+}
+
+func matchesBoolFilter(filter types.Bool, value bool) bool {
+	if filter.IsNull() || filter.IsUnknown() {
+		return true
+	}
+	return filter.ValueBool() == value
+}
+
 func getKeyValueFromRawJSON(in string, key string) (string, string, error) {
 
 	_raw := make(map[string]json.RawMessage)
@@ -61,6 +83,16 @@ func difference(a, b []string) []string {
 	return diff
 }
 
+func addDefaultTags(tags, defaults []string) []string {
+	result := make([]string, 0, len(tags)+len(defaults))
+	result = append(result, tags...)
+	return append(result, difference(defaults, tags)...)
+}
+
+func removeDefaultTags(tags, defaults, configured []string) []string {
+	return difference(tags, difference(defaults, configured))
+}
+
 func interSection[T constraints.Ordered](pS ...[]T) []T {
 	hash := make(map[T]*int) // value, counter
 	result := make([]T, 0)
@@ -0,0 +1,111 @@
+package uptycs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMatchesStringFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter types.String
+		value  string
+		want   bool
+	}{
+		{name: "null matches anything", filter: types.StringNull(), value: "x", want: true},
+		{name: "unknown matches anything", filter: types.StringUnknown(), value: "x", want: true},
+		{name: "equal", filter: types.StringValue("x"), value: "x", want: true},
+		{name: "different", filter: types.StringValue("x"), value: "y", want: false},
+		{name: "case-sensitive", filter: types.StringValue("X"), value: "x", want: false},
+		{name: "empty filter only matches empty", filter: types.StringValue(""), value: "x", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesStringFilter(tt.filter, tt.value); got != tt.want {
+				t.Errorf("matchesStringFilter(%v, %q) = %t, want %t", tt.filter, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesBoolFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter types.Bool
+		value  bool
+		want   bool
+	}{
+		{name: "null matches true", filter: types.BoolNull(), value: true, want: true},
+		{name: "null matches false", filter: types.BoolNull(), value: false, want: true},
+		{name: "unknown matches anything", filter: types.BoolUnknown(), value: false, want: true},
+		{name: "equal", filter: types.BoolValue(false), value: false, want: true},
+		{name: "different", filter: types.BoolValue(true), value: false, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesBoolFilter(tt.filter, tt.value); got != tt.want {
+				t.Errorf("matchesBoolFilter(%v, %t) = %t, want %t", tt.filter, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddDefaultTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     []string
+		defaults []string
+		want     []string
+	}{
+		{name: "no defaults", tags: []string{"a"}, defaults: nil, want: []string{"a"}},
+		{name: "no tags", tags: nil, defaults: []string{"team=sec"}, want: []string{"team=sec"}},
+		{name: "appended after configured tags", tags: []string{"a", "b"}, defaults: []string{"team=sec"}, want: []string{"a", "b", "team=sec"}},
+		{name: "already configured", tags: []string{"team=sec", "a"}, defaults: []string{"team=sec"}, want: []string{"team=sec", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := addDefaultTags(tt.tags, tt.defaults); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("addDefaultTags(%v, %v) = %v, want %v", tt.tags, tt.defaults, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddDefaultTagsDoesNotAliasInput(t *testing.T) {
+	backing := make([]string, 1, 4)
+	backing[0] = "a"
+	other := append(backing, "b")
+
+	addDefaultTags(backing, []string{"team=sec"})
+	if other[1] != "b" {
+		t.Errorf("addDefaultTags wrote into the caller's backing array: %v", other)
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	tests := []struct {
+		name       string
+		tags       []string
+		defaults   []string
+		configured []string
+		want       []string
+	}{
+		{name: "default removed", tags: []string{"a", "team=sec"}, defaults: []string{"team=sec"}, configured: []string{"a"}, want: []string{"a"}},
+		{name: "configured default kept", tags: []string{"team=sec", "a"}, defaults: []string{"team=sec"}, configured: []string{"team=sec", "a"}, want: []string{"team=sec", "a"}},
+		{name: "added outside terraform kept", tags: []string{"a", "manual"}, defaults: []string{"team=sec"}, configured: []string{"a"}, want: []string{"a", "manual"}},
+		{name: "round trip", tags: addDefaultTags([]string{"a"}, []string{"x", "y"}), defaults: []string{"x", "y"}, configured: []string{"a"}, want: []string{"a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := removeDefaultTags(tt.tags, tt.defaults, tt.configured); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("removeDefaultTags(%v, %v, %v) = %v, want %v", tt.tags, tt.defaults, tt.configured, got, tt.want)
+			}
+		})
+	}
+}
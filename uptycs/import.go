@@ -0,0 +1,74 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"regexp"
+	"strings"
+)
+
+const importNamePrefix = "name:"
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// importStateByName imports by ID, or by name when the import ID has the form
+// "name:<name>". lookup resolves a name to an ID the same way the data
+// sources do.
+func importStateByName(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, lookup func(name string) (string, error)) {
+	if !strings.HasPrefix(req.ID, importNamePrefix) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	id, err := lookup(strings.TrimPrefix(req.ID, importNamePrefix))
+	importStateResolved(ctx, req.ID, resp, id, err)
+}
+
+// lookupPrefixedName resolves name with lookup, trying it with the provider's
+// name_prefix first and then as is, since objects created in the console
+// don't carry the prefix.
+func lookupPrefixedName(prefix, name string, lookup func(name string) (string, error)) (string, error) {
+	if len(prefix) > 0 {
+		if id, err := lookup(prefix + name); err == nil && len(id) > 0 {
+			return id, nil
+		}
+	}
+	return lookup(name)
+}
+
+func importStateResolved(ctx context.Context, importID string, resp *resource.ImportStateResponse, id string, err error) {
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing",
+			"Could not find object matching "+importID+": "+err.Error(),
+		)
+		return
+	}
+	if len(id) == 0 {
+		resp.Diagnostics.AddError(
+			"Error importing",
+			"Could not find object matching "+importID,
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// readResourceState reads the object with the given ID into a fresh state
+// using the resource's own Read.
+func readResourceState(ctx context.Context, r resource.Resource, state tfsdk.State, id string) (tfsdk.State, diag.Diagnostics) {
+	state.Raw = tftypes.NewValue(state.Schema.Type().TerraformType(ctx), nil)
+	diags := state.SetAttribute(ctx, path.Root("id"), id)
+	if diags.HasError() {
+		return state, diags
+	}
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	diags.Append(readResp.Diagnostics...)
+	return readResp.State, diags
+}
@@ -0,0 +1,115 @@
+package uptycs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func importTestResponse() *resource.ImportStateResponse {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+	return &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: s,
+			Raw:    tftypes.NewValue(s.Type().TerraformType(context.Background()), nil),
+		},
+	}
+}
+
+func TestImportStateByName(t *testing.T) {
+	tests := []struct {
+		name       string
+		importID   string
+		lookupID   string
+		lookupErr  error
+		wantLookup string
+		wantID     string
+		wantError  bool
+	}{
+		{name: "id", importID: "11111111-2222-3333-4444-555555555555", wantID: "11111111-2222-3333-4444-555555555555"},
+		{name: "by name", importID: "name:My Rule", lookupID: "abc", wantLookup: "My Rule", wantID: "abc"},
+		{name: "name containing the prefix", importID: "name:name:x", lookupID: "abc", wantLookup: "name:x", wantID: "abc"},
+		{name: "lookup error", importID: "name:missing", lookupErr: errors.New("not found"), wantLookup: "missing", wantError: true},
+		{name: "empty result", importID: "name:missing", wantLookup: "missing", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			resp := importTestResponse()
+			looked := ""
+			importStateByName(ctx, resource.ImportStateRequest{ID: tt.importID}, resp, func(name string) (string, error) {
+				looked = name
+				return tt.lookupID, tt.lookupErr
+			})
+
+			if looked != tt.wantLookup {
+				t.Errorf("looked up %q, want %q", looked, tt.wantLookup)
+			}
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("errors = %v, want error %t", resp.Diagnostics.Errors(), tt.wantError)
+			}
+			if tt.wantError {
+				return
+			}
+
+			var id string
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if id != tt.wantID {
+				t.Errorf("id = %q, want %q", id, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestLookupPrefixedName(t *testing.T) {
+	objects := map[string]string{
+		"tf-Prefixed": "prefixed-id",
+		"Console":     "console-id",
+		"Both":        "bare-id",
+		"tf-Both":     "both-id",
+	}
+	lookup := func(name string) (string, error) {
+		if id, ok := objects[name]; ok {
+			return id, nil
+		}
+		return "", errors.New("not found")
+	}
+
+	tests := []struct {
+		name    string
+		prefix  string
+		lookup  string
+		wantID  string
+		wantErr bool
+	}{
+		{name: "prefixed", prefix: "tf-", lookup: "Prefixed", wantID: "prefixed-id"},
+		{name: "console object without the prefix", prefix: "tf-", lookup: "Console", wantID: "console-id"},
+		{name: "prefixed name wins", prefix: "tf-", lookup: "Both", wantID: "both-id"},
+		{name: "no prefix", prefix: "", lookup: "Both", wantID: "bare-id"},
+		{name: "missing", prefix: "tf-", lookup: "Missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := lookupPrefixedName(tt.prefix, tt.lookup, lookup)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tt.wantErr)
+			}
+			if id != tt.wantID {
+				t.Errorf("id = %q, want %q", id, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/exp/slices"
+	"regexp"
+	"strings"
+)
+
+// mitreTechnique is an entry in the embedded MITRE ATT&CK (Enterprise)
+// catalogue. Sub-techniques such as T1560.001 are validated against their
+// parent technique.
+type mitreTechnique struct {
+	ID      string
+	Name    string
+	Tactics []string
+}
+
+const (
+	mitreReconnaissance      = "Reconnaissance"
+	mitreResourceDevelopment = "Resource Development"
+	mitreInitialAccess       = "Initial Access"
+	mitreExecution           = "Execution"
+	mitrePersistence         = "Persistence"
+	mitrePrivilegeEscalation = "Privilege Escalation"
+	mitreDefenseEvasion      = "Defense Evasion"
+	mitreCredentialAccess    = "Credential Access"
+	mitreDiscovery           = "Discovery"
+	mitreLateralMovement     = "Lateral Movement"
+	mitreCollection          = "Collection"
+	mitreCommandAndControl   = "Command and Control"
+	mitreExfiltration        = "Exfiltration"
+	mitreImpact              = "Impact"
+)
+
+var mitreTactics = []string{
+	mitreReconnaissance,
+	mitreResourceDevelopment,
+	mitreInitialAccess,
+	mitreExecution,
+	mitrePersistence,
+	mitrePrivilegeEscalation,
+	mitreDefenseEvasion,
+	mitreCredentialAccess,
+	mitreDiscovery,
+	mitreLateralMovement,
+	mitreCollection,
+	mitreCommandAndControl,
+	mitreExfiltration,
+	mitreImpact,
+}
+
+var mitreTechniques = []mitreTechnique{
+	{ID: "T1595", Name: "Active Scanning", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1592", Name: "Gather Victim Host Information", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1589", Name: "Gather Victim Identity Information", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1590", Name: "Gather Victim Network Information", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1591", Name: "Gather Victim Org Information", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1598", Name: "Phishing for Information", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1597", Name: "Search Closed Sources", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1596", Name: "Search Open Technical Databases", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1593", Name: "Search Open Websites/Domains", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1594", Name: "Search Victim-Owned Websites", Tactics: []string{mitreReconnaissance}},
+	{ID: "T1650", Name: "Acquire Access", Tactics: []string{mitreResourceDevelopment}},
+	{ID: "T1583", Name: "Acquire Infrastructure", Tactics: []string{mitreResourceDevelopment}},
+	{ID: "T1586", Name: "Compromise Accounts", Tactics: []string{mitreResourceDevelopment}},
+	{ID: "T1584", Name: "Compromise Infrastructure", Tactics: []string{mitreResourceDevelopment}},
+	{ID: "T1587", Name: "Develop Capabilities", Tactics: []string{mitreResourceDevelopment}},
+	{ID: "T1585", Name: "Establish Accounts", Tactics: []string{mitreResourceDevelopment}},
+	{ID: "T1588", Name: "Obtain Capabilities", Tactics: []string{mitreResourceDevelopment}},
+	{ID: "T1608", Name: "Stage Capabilities", Tactics: []string{mitreResourceDevelopment}},
+	{ID: "T1189", Name: "Drive-by Compromise", Tactics: []string{mitreInitialAccess}},
+	{ID: "T1190", Name: "Exploit Public-Facing Application", Tactics: []string{mitreInitialAccess}},
+	{ID: "T1133", Name: "External Remote Services", Tactics: []string{mitreInitialAccess, mitrePersistence}},
+	{ID: "T1200", Name: "Hardware Additions", Tactics: []string{mitreInitialAccess}},
+	{ID: "T1566", Name: "Phishing", Tactics: []string{mitreInitialAccess}},
+	{ID: "T1091", Name: "Replication Through Removable Media", Tactics: []string{mitreInitialAccess, mitreLateralMovement}},
+	{ID: "T1195", Name: "Supply Chain Compromise", Tactics: []string{mitreInitialAccess}},
+	{ID: "T1199", Name: "Trusted Relationship", Tactics: []string{mitreInitialAccess}},
+	{ID: "T1078", Name: "Valid Accounts", Tactics: []string{mitreInitialAccess, mitrePersistence, mitrePrivilegeEscalation, mitreDefenseEvasion}},
+	{ID: "T1651", Name: "Cloud Administration Command", Tactics: []string{mitreExecution}},
+	{ID: "T1059", Name: "Command and Scripting Interpreter", Tactics: []string{mitreExecution}},
+	{ID: "T1609", Name: "Container Administration Command", Tactics: []string{mitreExecution}},
+	{ID: "T1610", Name: "Deploy Container", Tactics: []string{mitreExecution, mitreDefenseEvasion}},
+	{ID: "T1203", Name: "Exploitation for Client Execution", Tactics: []string{mitreExecution}},
+	{ID: "T1559", Name: "Inter-Process Communication", Tactics: []string{mitreExecution}},
+	{ID: "T1106", Name: "Native API", Tactics: []string{mitreExecution}},
+	{ID: "T1053", Name: "Scheduled Task/Job", Tactics: []string{mitreExecution, mitrePersistence, mitrePrivilegeEscalation}},
+	{ID: "T1648", Name: "Serverless Execution", Tactics: []string{mitreExecution}},
+	{ID: "T1129", Name: "Shared Modules", Tactics: []string{mitreExecution}},
+	{ID: "T1072", Name: "Software Deployment Tools", Tactics: []string{mitreExecution, mitreLateralMovement}},
+	{ID: "T1569", Name: "System Services", Tactics: []string{mitreExecution}},
+	{ID: "T1204", Name: "User Execution", Tactics: []string{mitreExecution}},
+	{ID: "T1047", Name: "Windows Management Instrumentation", Tactics: []string{mitreExecution}},
+	{ID: "T1098", Name: "Account Manipulation", Tactics: []string{mitrePersistence, mitrePrivilegeEscalation}},
+	{ID: "T1197", Name: "BITS Jobs", Tactics: []string{mitrePersistence, mitreDefenseEvasion}},
+	{ID: "T1547", Name: "Boot or Logon Autostart Execution", Tactics: []string{mitrePersistence, mitrePrivilegeEscalation}},
+	{ID: "T1037", Name: "Boot or Logon Initialization Scripts", Tactics: []string{mitrePersistence, mitrePrivilegeEscalation}},
+	{ID: "T1176", Name: "Browser Extensions", Tactics: []string{mitrePersistence}},
+	{ID: "T1554", Name: "Compromise Client Software Binary", Tactics: []string{mitrePersistence}},
+	{ID: "T1136", Name: "Create Account", Tactics: []string{mitrePersistence}},
+	{ID: "T1543", Name: "Create or Modify System Process", Tactics: []string{mitrePersistence, mitrePrivilegeEscalation}},
+	{ID: "T1546", Name: "Event Triggered Execution", Tactics: []string{mitrePersistence, mitrePrivilegeEscalation}},
+	{ID: "T1574", Name: "Hijack Execution Flow", Tactics: []string{mitrePersistence, mitrePrivilegeEscalation, mitreDefenseEvasion}},
+	{ID: "T1525", Name: "Implant Internal Image", Tactics: []string{mitrePersistence}},
+	{ID: "T1556", Name: "Modify Authentication Process", Tactics: []string{mitrePersistence, mitreDefenseEvasion, mitreCredentialAccess}},
+	{ID: "T1137", Name: "Office Application Startup", Tactics: []string{mitrePersistence}},
+	{ID: "T1653", Name: "Power Settings", Tactics: []string{mitrePersistence}},
+	{ID: "T1542", Name: "Pre-OS Boot", Tactics: []string{mitrePersistence, mitreDefenseEvasion}},
+	{ID: "T1505", Name: "Server Software Component", Tactics: []string{mitrePersistence}},
+	{ID: "T1205", Name: "Traffic Signaling", Tactics: []string{mitrePersistence, mitreDefenseEvasion, mitreCommandAndControl}},
+	{ID: "T1548", Name: "Abuse Elevation Control Mechanism", Tactics: []string{mitrePrivilegeEscalation, mitreDefenseEvasion}},
+	{ID: "T1134", Name: "Access Token Manipulation", Tactics: []string{mitrePrivilegeEscalation, mitreDefenseEvasion}},
+	{ID: "T1484", Name: "Domain Policy Modification", Tactics: []string{mitrePrivilegeEscalation, mitreDefenseEvasion}},
+	{ID: "T1611", Name: "Escape to Host", Tactics: []string{mitrePrivilegeEscalation}},
+	{ID: "T1068", Name: "Exploitation for Privilege Escalation", Tactics: []string{mitrePrivilegeEscalation}},
+	{ID: "T1055", Name: "Process Injection", Tactics: []string{mitrePrivilegeEscalation, mitreDefenseEvasion}},
+	{ID: "T1612", Name: "Build Image on Host", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1622", Name: "Debugger Evasion", Tactics: []string{mitreDefenseEvasion, mitreDiscovery}},
+	{ID: "T1140", Name: "Deobfuscate/Decode Files or Information", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1006", Name: "Direct Volume Access", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1480", Name: "Execution Guardrails", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1211", Name: "Exploitation for Defense Evasion", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1222", Name: "File and Directory Permissions Modification", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1564", Name: "Hide Artifacts", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1562", Name: "Impair Defenses", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1070", Name: "Indicator Removal", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1202", Name: "Indirect Command Execution", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1036", Name: "Masquerading", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1578", Name: "Modify Cloud Compute Infrastructure", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1112", Name: "Modify Registry", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1601", Name: "Modify System Image", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1599", Name: "Network Boundary Bridging", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1027", Name: "Obfuscated Files or Information", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1647", Name: "Plist File Modification", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1620", Name: "Reflective Code Loading", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1207", Name: "Rogue Domain Controller", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1014", Name: "Rootkit", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1553", Name: "Subvert Trust Controls", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1218", Name: "System Binary Proxy Execution", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1216", Name: "System Script Proxy Execution", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1221", Name: "Template Injection", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1127", Name: "Trusted Developer Utilities Proxy Execution", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1535", Name: "Unused/Unsupported Cloud Regions", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1550", Name: "Use Alternate Authentication Material", Tactics: []string{mitreDefenseEvasion, mitreLateralMovement}},
+	{ID: "T1497", Name: "Virtualization/Sandbox Evasion", Tactics: []string{mitreDefenseEvasion, mitreDiscovery}},
+	{ID: "T1600", Name: "Weaken Encryption", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1220", Name: "XSL Script Processing", Tactics: []string{mitreDefenseEvasion}},
+	{ID: "T1557", Name: "Adversary-in-the-Middle", Tactics: []string{mitreCredentialAccess, mitreCollection}},
+	{ID: "T1110", Name: "Brute Force", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1555", Name: "Credentials from Password Stores", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1212", Name: "Exploitation for Credential Access", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1187", Name: "Forced Authentication", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1606", Name: "Forge Web Credentials", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1056", Name: "Input Capture", Tactics: []string{mitreCredentialAccess, mitreCollection}},
+	{ID: "T1111", Name: "Multi-Factor Authentication Interception", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1621", Name: "Multi-Factor Authentication Request Generation", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1040", Name: "Network Sniffing", Tactics: []string{mitreCredentialAccess, mitreDiscovery}},
+	{ID: "T1003", Name: "OS Credential Dumping", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1528", Name: "Steal Application Access Token", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1649", Name: "Steal or Forge Authentication Certificates", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1558", Name: "Steal or Forge Kerberos Tickets", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1539", Name: "Steal Web Session Cookie", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1552", Name: "Unsecured Credentials", Tactics: []string{mitreCredentialAccess}},
+	{ID: "T1087", Name: "Account Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1010", Name: "Application Window Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1217", Name: "Browser Information Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1580", Name: "Cloud Infrastructure Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1538", Name: "Cloud Service Dashboard", Tactics: []string{mitreDiscovery}},
+	{ID: "T1526", Name: "Cloud Service Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1619", Name: "Cloud Storage Object Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1613", Name: "Container and Resource Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1482", Name: "Domain Trust Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1083", Name: "File and Directory Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1615", Name: "Group Policy Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1654", Name: "Log Enumeration", Tactics: []string{mitreDiscovery}},
+	{ID: "T1046", Name: "Network Service Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1135", Name: "Network Share Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1201", Name: "Password Policy Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1120", Name: "Peripheral Device Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1069", Name: "Permission Groups Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1057", Name: "Process Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1012", Name: "Query Registry", Tactics: []string{mitreDiscovery}},
+	{ID: "T1018", Name: "Remote System Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1518", Name: "Software Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1082", Name: "System Information Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1614", Name: "System Location Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1016", Name: "System Network Configuration Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1049", Name: "System Network Connections Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1033", Name: "System Owner/User Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1007", Name: "System Service Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1124", Name: "System Time Discovery", Tactics: []string{mitreDiscovery}},
+	{ID: "T1210", Name: "Exploitation of Remote Services", Tactics: []string{mitreLateralMovement}},
+	{ID: "T1534", Name: "Internal Spearphishing", Tactics: []string{mitreLateralMovement}},
+	{ID: "T1570", Name: "Lateral Tool Transfer", Tactics: []string{mitreLateralMovement}},
+	{ID: "T1563", Name: "Remote Service Session Hijacking", Tactics: []string{mitreLateralMovement}},
+	{ID: "T1021", Name: "Remote Services", Tactics: []string{mitreLateralMovement}},
+	{ID: "T1080", Name: "Taint Shared Content", Tactics: []string{mitreLateralMovement}},
+	{ID: "T1560", Name: "Archive Collected Data", Tactics: []string{mitreCollection}},
+	{ID: "T1123", Name: "Audio Capture", Tactics: []string{mitreCollection}},
+	{ID: "T1119", Name: "Automated Collection", Tactics: []string{mitreCollection}},
+	{ID: "T1185", Name: "Browser Session Hijacking", Tactics: []string{mitreCollection}},
+	{ID: "T1115", Name: "Clipboard Data", Tactics: []string{mitreCollection}},
+	{ID: "T1530", Name: "Data from Cloud Storage", Tactics: []string{mitreCollection}},
+	{ID: "T1602", Name: "Data from Configuration Repository", Tactics: []string{mitreCollection}},
+	{ID: "T1213", Name: "Data from Information Repositories", Tactics: []string{mitreCollection}},
+	{ID: "T1005", Name: "Data from Local System", Tactics: []string{mitreCollection}},
+	{ID: "T1039", Name: "Data from Network Shared Drive", Tactics: []string{mitreCollection}},
+	{ID: "T1025", Name: "Data from Removable Media", Tactics: []string{mitreCollection}},
+	{ID: "T1074", Name: "Data Staged", Tactics: []string{mitreCollection}},
+	{ID: "T1114", Name: "Email Collection", Tactics: []string{mitreCollection}},
+	{ID: "T1113", Name: "Screen Capture", Tactics: []string{mitreCollection}},
+	{ID: "T1125", Name: "Video Capture", Tactics: []string{mitreCollection}},
+	{ID: "T1071", Name: "Application Layer Protocol", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1092", Name: "Communication Through Removable Media", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1132", Name: "Data Encoding", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1001", Name: "Data Obfuscation", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1568", Name: "Dynamic Resolution", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1573", Name: "Encrypted Channel", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1008", Name: "Fallback Channels", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1105", Name: "Ingress Tool Transfer", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1104", Name: "Multi-Stage Channels", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1095", Name: "Non-Application Layer Protocol", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1571", Name: "Non-Standard Port", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1572", Name: "Protocol Tunneling", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1090", Name: "Proxy", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1219", Name: "Remote Access Software", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1102", Name: "Web Service", Tactics: []string{mitreCommandAndControl}},
+	{ID: "T1020", Name: "Automated Exfiltration", Tactics: []string{mitreExfiltration}},
+	{ID: "T1030", Name: "Data Transfer Size Limits", Tactics: []string{mitreExfiltration}},
+	{ID: "T1048", Name: "Exfiltration Over Alternative Protocol", Tactics: []string{mitreExfiltration}},
+	{ID: "T1041", Name: "Exfiltration Over C2 Channel", Tactics: []string{mitreExfiltration}},
+	{ID: "T1011", Name: "Exfiltration Over Other Network Medium", Tactics: []string{mitreExfiltration}},
+	{ID: "T1052", Name: "Exfiltration Over Physical Medium", Tactics: []string{mitreExfiltration}},
+	{ID: "T1567", Name: "Exfiltration Over Web Service", Tactics: []string{mitreExfiltration}},
+	{ID: "T1029", Name: "Scheduled Transfer", Tactics: []string{mitreExfiltration}},
+	{ID: "T1537", Name: "Transfer Data to Cloud Account", Tactics: []string{mitreExfiltration}},
+	{ID: "T1531", Name: "Account Access Removal", Tactics: []string{mitreImpact}},
+	{ID: "T1485", Name: "Data Destruction", Tactics: []string{mitreImpact}},
+	{ID: "T1486", Name: "Data Encrypted for Impact", Tactics: []string{mitreImpact}},
+	{ID: "T1565", Name: "Data Manipulation", Tactics: []string{mitreImpact}},
+	{ID: "T1491", Name: "Defacement", Tactics: []string{mitreImpact}},
+	{ID: "T1561", Name: "Disk Wipe", Tactics: []string{mitreImpact}},
+	{ID: "T1499", Name: "Endpoint Denial of Service", Tactics: []string{mitreImpact}},
+	{ID: "T1657", Name: "Financial Theft", Tactics: []string{mitreImpact}},
+	{ID: "T1495", Name: "Firmware Corruption", Tactics: []string{mitreImpact}},
+	{ID: "T1490", Name: "Inhibit System Recovery", Tactics: []string{mitreImpact}},
+	{ID: "T1498", Name: "Network Denial of Service", Tactics: []string{mitreImpact}},
+	{ID: "T1496", Name: "Resource Hijacking", Tactics: []string{mitreImpact}},
+	{ID: "T1489", Name: "Service Stop", Tactics: []string{mitreImpact}},
+	{ID: "T1529", Name: "System Shutdown/Reboot", Tactics: []string{mitreImpact}},
+}
+
+var mitreTechniqueIDRegex = regexp.MustCompile(`^(T[0-9]{4})(\.[0-9]{3})?$`)
+
+func getMITRETactic(name string) (string, bool) {
+	for _, t := range mitreTactics {
+		if strings.EqualFold(t, name) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+func getMITRETechnique(id string) (mitreTechnique, bool) {
+	matches := mitreTechniqueIDRegex.FindStringSubmatch(strings.ToUpper(id))
+	if matches == nil {
+		return mitreTechnique{}, false
+	}
+	for _, t := range mitreTechniques {
+		if t.ID == matches[1] {
+			return t, true
+		}
+	}
+	return mitreTechnique{}, false
+}
+
+// validateMITREGrouping checks grouping_l2 (tactic) and grouping_l3 (technique)
+// against the embedded catalogue when grouping is MITRE. Names are matched
+// case-insensitively.
+func validateMITREGrouping(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var grouping, tactic, technique types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("grouping"), &grouping)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("grouping_l2"), &tactic)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("grouping_l3"), &technique)...)
+	if diags.HasError() || !strings.EqualFold(grouping.ValueString(), "MITRE") {
+		return
+	}
+
+	tacticName, validTactic := "", false
+	if !tactic.IsUnknown() && tactic.ValueString() != "" {
+		if tacticName, validTactic = getMITRETactic(tactic.ValueString()); !validTactic {
+			diags.AddAttributeError(path.Root("grouping_l2"), "Unknown MITRE tactic", "Tactic "+tactic.ValueString()+" is not a MITRE ATT&CK tactic. See the uptycs_mitre_techniques data source for valid tactics.")
+		}
+	}
+
+	if technique.IsUnknown() || technique.ValueString() == "" {
+		return
+	}
+	t, ok := getMITRETechnique(technique.ValueString())
+	if !ok {
+		diags.AddAttributeError(path.Root("grouping_l3"), "Unknown MITRE technique", "Technique "+technique.ValueString()+" is not a MITRE ATT&CK technique. See the uptycs_mitre_techniques data source for valid techniques.")
+		return
+	}
+	if validTactic && !slices.Contains(t.Tactics, tacticName) {
+		diags.AddAttributeError(path.Root("grouping_l3"), "MITRE technique does not match tactic", "Technique "+technique.ValueString()+" ("+t.Name+") does not belong to tactic "+tacticName+".")
+	}
+}
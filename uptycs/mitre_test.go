@@ -0,0 +1,64 @@
+package uptycs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func mitreGroupingConfig(grouping, tactic, technique string) tfsdk.Config {
+	return tfsdk.Config{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"grouping":    schema.StringAttribute{Optional: true},
+				"grouping_l2": schema.StringAttribute{Optional: true},
+				"grouping_l3": schema.StringAttribute{Optional: true},
+			},
+		},
+		Raw: tftypes.NewValue(
+			tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+				"grouping":    tftypes.String,
+				"grouping_l2": tftypes.String,
+				"grouping_l3": tftypes.String,
+			}},
+			map[string]tftypes.Value{
+				"grouping":    tftypes.NewValue(tftypes.String, grouping),
+				"grouping_l2": tftypes.NewValue(tftypes.String, tactic),
+				"grouping_l3": tftypes.NewValue(tftypes.String, technique),
+			},
+		),
+	}
+}
+
+func TestValidateMITREGrouping(t *testing.T) {
+	tests := []struct {
+		name      string
+		grouping  string
+		tactic    string
+		technique string
+		errors    int
+	}{
+		{name: "valid", grouping: "MITRE", tactic: "Collection", technique: "T1560", errors: 0},
+		{name: "sub-technique", grouping: "MITRE", tactic: "Collection", technique: "T1560.001", errors: 0},
+		{name: "case-insensitive", grouping: "mitre", tactic: "credential access", technique: "t1003", errors: 0},
+		{name: "technique without tactic", grouping: "MITRE", tactic: "", technique: "T1485", errors: 0},
+		{name: "not MITRE", grouping: "Custom", tactic: "Anything", technique: "whatever", errors: 0},
+		{name: "unknown tactic", grouping: "MITRE", tactic: "Snacking", technique: "T1560", errors: 1},
+		{name: "unknown technique", grouping: "MITRE", tactic: "Collection", technique: "T0000", errors: 1},
+		{name: "technique from another tactic", grouping: "MITRE", tactic: "Impact", technique: "T1560", errors: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateMITREGrouping(context.Background(), mitreGroupingConfig(tt.grouping, tt.tactic, tt.technique), &diags)
+			if got := diags.ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d: %v", got, tt.errors, diags.Errors())
+			}
+		})
+	}
+}
@@ -18,6 +18,31 @@ type AlertRuleLite struct {
 }
 
 type AlertRule struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	Code                types.String `tfsdk:"code"`
+	Type                types.String `tfsdk:"type"`
+	Rule                types.String `tfsdk:"rule"`
+	Grouping            types.String `tfsdk:"grouping"`
+	Enabled             types.Bool   `tfsdk:"enabled"`
+	Throttled           types.Bool   `tfsdk:"throttled"`
+	IsInternal          types.Bool   `tfsdk:"is_internal"`
+	AlertTags           types.List   `tfsdk:"alert_tags"`
+	GroupingL2          types.String `tfsdk:"grouping_l2"`
+	GroupingL3          types.String `tfsdk:"grouping_l3"`
+	AlertNotifyInterval types.Int64  `tfsdk:"notify_interval"`
+	AlertNotifyCount    types.Int64  `tfsdk:"notify_count"`
+	AlertRuleExceptions types.List   `tfsdk:"rule_exceptions"`
+	Destinations        types.List   `tfsdk:"destinations"`
+	SQLConfig           *SQLConfig   `tfsdk:"sql_config"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
+	OnDestroy           types.String `tfsdk:"on_destroy"`
+}
+
+// AlertRuleData is the uptycs_alert_rule data source's view of AlertRule, without the
+// settings that only affect how the resource is managed.
+type AlertRuleData struct {
 	ID                  types.String           `tfsdk:"id"`
 	Name                types.String           `tfsdk:"name"`
 	Description         types.String           `tfsdk:"description"`
@@ -45,6 +70,21 @@ type AlertRuleDestination struct {
 	CloseAfterDelivery types.Bool   `tfsdk:"close_after_delivery"`
 }
 
+type AlertRuleDestinationAttachment struct {
+	ID                 types.String `tfsdk:"id"`
+	RuleID             types.String `tfsdk:"rule_id"`
+	DestinationID      types.String `tfsdk:"destination_id"`
+	Severity           types.String `tfsdk:"severity"`
+	NotifyEveryAlert   types.Bool   `tfsdk:"notify_every_alert"`
+	CloseAfterDelivery types.Bool   `tfsdk:"close_after_delivery"`
+}
+
+type AlertRuleExceptionAttachment struct {
+	ID          types.String `tfsdk:"id"`
+	RuleID      types.String `tfsdk:"rule_id"`
+	ExceptionID types.String `tfsdk:"exception_id"`
+}
+
 type SQLConfig struct {
 	IntervalSeconds types.Int64 `tfsdk:"interval_seconds"`
 }
@@ -59,9 +99,46 @@ type Exception struct {
 	Disabled        types.Bool   `tfsdk:"disabled"`
 	CloseOpenAlerts types.Bool   `tfsdk:"close_open_alerts"`
 	Rule            types.String `tfsdk:"rule"`
+	OnDestroy       types.String `tfsdk:"on_destroy"`
+}
+
+// ExceptionData is the uptycs_exception data source's view of Exception, without the
+// settings that only affect how the resource is managed.
+type ExceptionData struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	ExceptionType   types.String `tfsdk:"exception_type"`
+	TableName       types.String `tfsdk:"table_name"`
+	IsGlobal        types.Bool   `tfsdk:"is_global"`
+	Disabled        types.Bool   `tfsdk:"disabled"`
+	CloseOpenAlerts types.Bool   `tfsdk:"close_open_alerts"`
+	Rule            types.String `tfsdk:"rule"`
 }
 
 type EventRule struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Description        types.String   `tfsdk:"description"`
+	Code               types.String   `tfsdk:"code"`
+	Type               types.String   `tfsdk:"type"`
+	Rule               types.String   `tfsdk:"rule"`
+	Grouping           types.String   `tfsdk:"grouping"`
+	GroupingL2         types.String   `tfsdk:"grouping_l2"`
+	GroupingL3         types.String   `tfsdk:"grouping_l3"`
+	Score              types.String   `tfsdk:"score"`
+	Enabled            types.Bool     `tfsdk:"enabled"`
+	EventTags          types.List     `tfsdk:"event_tags"`
+	BuilderConfig      *BuilderConfig `tfsdk:"builder_config"`
+	AlertRule          *AlertRuleLite `tfsdk:"alert_rule"`
+	SQLConfig          *SQLConfig     `tfsdk:"sql_config"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	OnDestroy          types.String   `tfsdk:"on_destroy"`
+}
+
+// EventRuleData is the uptycs_event_rule data source's view of EventRule, without the
+// settings that only affect how the resource is managed.
+type EventRuleData struct {
 	ID            types.String   `tfsdk:"id"`
 	Name          types.String   `tfsdk:"name"`
 	Description   types.String   `tfsdk:"description"`
@@ -97,6 +174,19 @@ type AutoAlertConfig struct {
 }
 
 type Destination struct {
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	Type               types.String      `tfsdk:"type"`
+	Address            types.String      `tfsdk:"address"`
+	Enabled            types.Bool        `tfsdk:"enabled"`
+	Config             DestinationConfig `tfsdk:"config"`
+	Template           types.String      `tfsdk:"template"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+}
+
+// DestinationData is the uptycs_destination data source's view of Destination, without the
+// settings that only affect how the resource is managed.
+type DestinationData struct {
 	ID       types.String      `tfsdk:"id"`
 	Name     types.String      `tfsdk:"name"`
 	Type     types.String      `tfsdk:"type"`
@@ -141,6 +231,25 @@ type User struct {
 	AlertHiddenColumns types.List   `tfsdk:"alert_hidden_columns"`
 	Roles              types.List   `tfsdk:"roles"`
 	UserObjectGroups   types.List   `tfsdk:"user_object_groups"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+// UserData is the uptycs_user data source's view of User, without the
+// settings that only affect how the resource is managed.
+type UserData struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Email              types.String `tfsdk:"email"`
+	Phone              types.String `tfsdk:"phone"`
+	Active             types.Bool   `tfsdk:"active"`
+	SuperAdmin         types.Bool   `tfsdk:"super_admin"`
+	Bot                types.Bool   `tfsdk:"bot"`
+	Support            types.Bool   `tfsdk:"support"`
+	ImageURL           types.String `tfsdk:"image_url"`
+	MaxIdleTimeMins    types.Int64  `tfsdk:"max_idle_time_mins"`
+	AlertHiddenColumns types.List   `tfsdk:"alert_hidden_columns"`
+	Roles              types.List   `tfsdk:"roles"`
+	UserObjectGroups   types.List   `tfsdk:"user_object_groups"`
 }
 
 type Role struct {
@@ -151,6 +260,19 @@ type Role struct {
 	Hidden               types.Bool   `tfsdk:"hidden"`
 	NoMinimalPermissions types.Bool   `tfsdk:"no_minimal_permissions"`
 	RoleObjectGroups     types.List   `tfsdk:"role_object_groups"`
+	DeletionProtection   types.Bool   `tfsdk:"deletion_protection"`
+}
+
+// RoleData is the uptycs_role data source's view of Role, without the
+// settings that only affect how the resource is managed.
+type RoleData struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Permissions          types.List   `tfsdk:"permissions"`
+	Hidden               types.Bool   `tfsdk:"hidden"`
+	NoMinimalPermissions types.Bool   `tfsdk:"no_minimal_permissions"`
+	RoleObjectGroups     types.List   `tfsdk:"role_object_groups"`
 }
 
 type ObjectGroup struct {
@@ -182,6 +304,24 @@ type TagRule struct {
 	Enabled        types.Bool   `tfsdk:"enabled"`
 	System         types.Bool   `tfsdk:"system"`
 	ResourceType   types.String `tfsdk:"resource_type"`
+	OnDestroy      types.String `tfsdk:"on_destroy"`
+}
+
+// TagRuleData is the uptycs_tag_rule data source's view of TagRule, without the
+// settings that only affect how the resource is managed.
+type TagRuleData struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Query          types.String `tfsdk:"query"`
+	Source         types.String `tfsdk:"source"`
+	RunOnce        types.Bool   `tfsdk:"run_once"`
+	Interval       types.Int64  `tfsdk:"interval"`
+	OSqueryVersion types.String `tfsdk:"osquery_version"`
+	Platform       types.String `tfsdk:"platform"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	System         types.Bool   `tfsdk:"system"`
+	ResourceType   types.String `tfsdk:"resource_type"`
 }
 
 type Tag struct {
@@ -206,13 +346,13 @@ type Tag struct {
 	RegistryPaths             types.List   `tfsdk:"registry_paths"`
 	YaraGroupRules            types.List   `tfsdk:"yara_group_rules"`
 	AuditConfigurations       types.List   `tfsdk:"audit_configurations"`
-	//ImageLoadExclusions # TODO: cant find any examples of this
-	//AuditGroups         # TODO: cant find any examples of this
-	//Destinations        # TODO: cant find any examples of this
-	//Redactions          # TODO: cant find any examples of this
-	//AuditRules          # TODO: cant find any examples of this
-	//PrometheusTargets   # TODO: cant find any examples of this
-	//AtcQueries          # TODO: cant find any examples of this
+	ImageLoadExclusions       types.List   `tfsdk:"image_load_exclusions"`
+	AuditGroups               types.List   `tfsdk:"audit_groups"`
+	Destinations              types.List   `tfsdk:"destinations"`
+	Redactions                types.List   `tfsdk:"redactions"`
+	AuditRules                types.List   `tfsdk:"audit_rules"`
+	PrometheusTargets         types.List   `tfsdk:"prometheus_targets"`
+	AtcQueries                types.List   `tfsdk:"atc_queries"`
 }
 
 type FilePathGroup struct {
@@ -352,3 +492,257 @@ type ComplianceProfile struct {
 	Description types.String `tfsdk:"description"`
 	Priority    types.Int64  `tfsdk:"priority"`
 }
+
+type ProcessBlockRule struct {
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Description types.String     `tfsdk:"description"`
+	Enabled     types.Bool       `tfsdk:"enabled"`
+	Rules       []BlockRuleEntry `tfsdk:"rules"`
+}
+
+type DNSBlockRule struct {
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Description types.String     `tfsdk:"description"`
+	Enabled     types.Bool       `tfsdk:"enabled"`
+	Rules       []BlockRuleEntry `tfsdk:"rules"`
+}
+
+type BlockRuleEntry struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Rule        types.String `tfsdk:"rule"`
+}
+
+type WindowsDefenderPreference struct {
+	ID          types.String                `tfsdk:"id"`
+	Name        types.String                `tfsdk:"name"`
+	Description types.String                `tfsdk:"description"`
+	Preferences *WindowsDefenderPreferences `tfsdk:"preferences"`
+}
+
+type WindowsDefenderPreferences struct {
+	DisableRealtimeMonitoring     types.Bool   `tfsdk:"disable_realtime_monitoring"`
+	DisableBehaviorMonitoring     types.Bool   `tfsdk:"disable_behavior_monitoring"`
+	DisableIOAVProtection         types.Bool   `tfsdk:"disable_ioav_protection"`
+	DisableScriptScanning         types.Bool   `tfsdk:"disable_script_scanning"`
+	DisableArchiveScanning        types.Bool   `tfsdk:"disable_archive_scanning"`
+	DisableRemovableDriveScanning types.Bool   `tfsdk:"disable_removable_drive_scanning"`
+	DisableEmailScanning          types.Bool   `tfsdk:"disable_email_scanning"`
+	PUAProtection                 types.String `tfsdk:"pua_protection"`
+	CloudBlockLevel               types.String `tfsdk:"cloud_block_level"`
+	CloudExtendedTimeout          types.Int64  `tfsdk:"cloud_extended_timeout"`
+	MAPSReporting                 types.String `tfsdk:"maps_reporting"`
+	SubmitSamplesConsent          types.String `tfsdk:"submit_samples_consent"`
+	EnableNetworkProtection       types.String `tfsdk:"enable_network_protection"`
+	EnableControlledFolderAccess  types.String `tfsdk:"enable_controlled_folder_access"`
+	SignatureUpdateInterval       types.Int64  `tfsdk:"signature_update_interval"`
+	ExclusionPaths                types.List   `tfsdk:"exclusion_paths"`
+	ExclusionExtensions           types.List   `tfsdk:"exclusion_extensions"`
+	ExclusionProcesses            types.List   `tfsdk:"exclusion_processes"`
+}
+
+type Redaction struct {
+	ID          types.String     `tfsdk:"id"`
+	Name        types.String     `tfsdk:"name"`
+	Description types.String     `tfsdk:"description"`
+	Enabled     types.Bool       `tfsdk:"enabled"`
+	Rules       []RedactionEntry `tfsdk:"rules"`
+}
+
+type RedactionEntry struct {
+	TableName  types.String `tfsdk:"table_name"`
+	ColumnName types.String `tfsdk:"column_name"`
+	Regex      types.String `tfsdk:"regex"`
+}
+
+type PrometheusTarget struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	URLs           types.List   `tfsdk:"urls"`
+	ScrapeInterval types.Int64  `tfsdk:"scrape_interval"`
+	Labels         types.Map    `tfsdk:"labels"`
+}
+
+type ImageLoadExclusion struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ImagePath   types.String `tfsdk:"image_path"`
+	ProcessPath types.String `tfsdk:"process_path"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+type AuditRule struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Rule        types.String `tfsdk:"rule"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+type AuditGroup struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	AuditRules  types.List   `tfsdk:"audit_rules"`
+}
+
+type AlertRules struct {
+	Grouping   types.String     `tfsdk:"grouping"`
+	GroupingL2 types.String     `tfsdk:"grouping_l2"`
+	Enabled    types.Bool       `tfsdk:"enabled"`
+	IsInternal types.Bool       `tfsdk:"is_internal"`
+	Type       types.String     `tfsdk:"type"`
+	NameRegex  types.String     `tfsdk:"name_regex"`
+	IDs        types.List       `tfsdk:"ids"`
+	AlertRules []AlertRulesItem `tfsdk:"alert_rules"`
+}
+
+type AlertRulesItem struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Code        types.String `tfsdk:"code"`
+	Type        types.String `tfsdk:"type"`
+	Grouping    types.String `tfsdk:"grouping"`
+	GroupingL2  types.String `tfsdk:"grouping_l2"`
+	GroupingL3  types.String `tfsdk:"grouping_l3"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	IsInternal  types.Bool   `tfsdk:"is_internal"`
+}
+
+type Tags struct {
+	Key          types.String `tfsdk:"key"`
+	Value        types.String `tfsdk:"value"`
+	Source       types.String `tfsdk:"source"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	System       types.Bool   `tfsdk:"system"`
+	Status       types.String `tfsdk:"status"`
+	IDs          types.List   `tfsdk:"ids"`
+	Tags         []TagsItem   `tfsdk:"tags"`
+}
+
+type TagsItem struct {
+	ID                        types.String `tfsdk:"id"`
+	Key                       types.String `tfsdk:"key"`
+	Value                     types.String `tfsdk:"value"`
+	Source                    types.String `tfsdk:"source"`
+	ResourceType              types.String `tfsdk:"resource_type"`
+	System                    types.Bool   `tfsdk:"system"`
+	Status                    types.String `tfsdk:"status"`
+	FlagProfile               types.String `tfsdk:"flag_profile"`
+	CustomProfile             types.String `tfsdk:"custom_profile"`
+	ComplianceProfile         types.String `tfsdk:"compliance_profile"`
+	ProcessBlockRule          types.String `tfsdk:"process_block_rule"`
+	DNSBlockRule              types.String `tfsdk:"dns_block_rule"`
+	WindowsDefenderPreference types.String `tfsdk:"windows_defender_preference"`
+	TagRule                   types.String `tfsdk:"tag_rule"`
+}
+
+type Users struct {
+	Active types.Bool   `tfsdk:"active"`
+	Bot    types.Bool   `tfsdk:"bot"`
+	Role   types.String `tfsdk:"role"`
+	IDs    types.List   `tfsdk:"ids"`
+	Users  []UsersItem  `tfsdk:"users"`
+}
+
+type UsersItem struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Email              types.String `tfsdk:"email"`
+	Phone              types.String `tfsdk:"phone"`
+	Active             types.Bool   `tfsdk:"active"`
+	SuperAdmin         types.Bool   `tfsdk:"super_admin"`
+	Bot                types.Bool   `tfsdk:"bot"`
+	Support            types.Bool   `tfsdk:"support"`
+	ImageURL           types.String `tfsdk:"image_url"`
+	MaxIdleTimeMins    types.Int64  `tfsdk:"max_idle_time_mins"`
+	AlertHiddenColumns types.List   `tfsdk:"alert_hidden_columns"`
+	Roles              types.List   `tfsdk:"roles"`
+	RoleNames          types.List   `tfsdk:"role_names"`
+	UserObjectGroups   types.List   `tfsdk:"user_object_groups"`
+}
+
+type Roles struct {
+	Name  types.String `tfsdk:"name"`
+	IDs   types.List   `tfsdk:"ids"`
+	Roles []RolesItem  `tfsdk:"roles"`
+}
+
+type RolesItem struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Permissions          types.List   `tfsdk:"permissions"`
+	Hidden               types.Bool   `tfsdk:"hidden"`
+	NoMinimalPermissions types.Bool   `tfsdk:"no_minimal_permissions"`
+	RoleObjectGroups     types.List   `tfsdk:"role_object_groups"`
+}
+
+type Permissions struct {
+	Permissions types.List `tfsdk:"permissions"`
+}
+
+type QueryJob struct {
+	Query   types.String `tfsdk:"query"`
+	Type    types.String `tfsdk:"type"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+	MaxRows types.Int64  `tfsdk:"max_rows"`
+	Rows    types.List   `tfsdk:"rows"`
+}
+
+type Assets struct {
+	Hostname       types.String `tfsdk:"hostname"`
+	Os             types.String `tfsdk:"os"`
+	Platform       types.String `tfsdk:"platform"`
+	Tag            types.String `tfsdk:"tag"`
+	ObjectGroup    types.String `tfsdk:"object_group"`
+	LastSeenWithin types.String `tfsdk:"last_seen_within"`
+	IDs            types.List   `tfsdk:"ids"`
+	Assets         []AssetsItem `tfsdk:"assets"`
+}
+
+type AssetsItem struct {
+	ID             types.String `tfsdk:"id"`
+	Hostname       types.String `tfsdk:"hostname"`
+	Os             types.String `tfsdk:"os"`
+	OsVersion      types.String `tfsdk:"os_version"`
+	Platform       types.String `tfsdk:"platform"`
+	OsqueryVersion types.String `tfsdk:"osquery_version"`
+	ObjectGroup    types.String `tfsdk:"object_group"`
+	LastSeen       types.String `tfsdk:"last_seen"`
+	Tags           types.List   `tfsdk:"tags"`
+}
+
+type TableSchema struct {
+	Name   types.String       `tfsdk:"name"`
+	Tables []TableSchemaTable `tfsdk:"tables"`
+}
+
+type TableSchemaTable struct {
+	Name        types.String        `tfsdk:"name"`
+	Description types.String        `tfsdk:"description"`
+	Columns     []TableSchemaColumn `tfsdk:"columns"`
+}
+
+type TableSchemaColumn struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+}
+
+type MITRETechniques struct {
+	Tactic     types.String     `tfsdk:"tactic"`
+	Tactics    types.List       `tfsdk:"tactics"`
+	Techniques []MITRETechnique `tfsdk:"techniques"`
+}
+
+type MITRETechnique struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Tactics types.List   `tfsdk:"tactics"`
+}
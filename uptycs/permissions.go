@@ -0,0 +1,174 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"sync"
+)
+
+// embeddedPermissions is a snapshot of the permission catalogue, used to
+// validate uptycs_role.permissions when the tenant catalogue can't be fetched.
+var embeddedPermissions = []string{
+	"ALERT:CREATE",
+	"ALERT:DELETE",
+	"ALERT:READ",
+	"ALERT:UPDATE",
+	"ALERT_RULE:CREATE",
+	"ALERT_RULE:DELETE",
+	"ALERT_RULE:READ",
+	"ALERT_RULE:UPDATE",
+	"API_KEY:CREATE",
+	"API_KEY:DELETE",
+	"API_KEY:READ",
+	"API_KEY:UPDATE",
+	"ASSET:CREATE",
+	"ASSET:DELETE",
+	"ASSET:READ",
+	"ASSET:UPDATE",
+	"ASSET_GROUP:CREATE",
+	"ASSET_GROUP:DELETE",
+	"ASSET_GROUP:READ",
+	"ASSET_GROUP:UPDATE",
+	"AUDIT_LOG:CREATE",
+	"AUDIT_LOG:DELETE",
+	"AUDIT_LOG:READ",
+	"AUDIT_LOG:UPDATE",
+	"COMPLIANCE:CREATE",
+	"COMPLIANCE:DELETE",
+	"COMPLIANCE:READ",
+	"COMPLIANCE:UPDATE",
+	"CUSTOM_PROFILE:CREATE",
+	"CUSTOM_PROFILE:DELETE",
+	"CUSTOM_PROFILE:READ",
+	"CUSTOM_PROFILE:UPDATE",
+	"DASHBOARD:CREATE",
+	"DASHBOARD:DELETE",
+	"DASHBOARD:READ",
+	"DASHBOARD:UPDATE",
+	"DESTINATION:CREATE",
+	"DESTINATION:DELETE",
+	"DESTINATION:READ",
+	"DESTINATION:UPDATE",
+	"DETECTION:CREATE",
+	"DETECTION:DELETE",
+	"DETECTION:READ",
+	"DETECTION:UPDATE",
+	"EVENT:CREATE",
+	"EVENT:DELETE",
+	"EVENT:READ",
+	"EVENT:UPDATE",
+	"EVENT_RULE:CREATE",
+	"EVENT_RULE:DELETE",
+	"EVENT_RULE:READ",
+	"EVENT_RULE:UPDATE",
+	"EXCEPTION:CREATE",
+	"EXCEPTION:DELETE",
+	"EXCEPTION:READ",
+	"EXCEPTION:UPDATE",
+	"FILE_PATH_GROUP:CREATE",
+	"FILE_PATH_GROUP:DELETE",
+	"FILE_PATH_GROUP:READ",
+	"FILE_PATH_GROUP:UPDATE",
+	"FLAG_PROFILE:CREATE",
+	"FLAG_PROFILE:DELETE",
+	"FLAG_PROFILE:READ",
+	"FLAG_PROFILE:UPDATE",
+	"INVESTIGATE:CREATE",
+	"INVESTIGATE:DELETE",
+	"INVESTIGATE:READ",
+	"INVESTIGATE:UPDATE",
+	"LOOKUP_TABLE:CREATE",
+	"LOOKUP_TABLE:DELETE",
+	"LOOKUP_TABLE:READ",
+	"LOOKUP_TABLE:UPDATE",
+	"OBJECT_GROUP:CREATE",
+	"OBJECT_GROUP:DELETE",
+	"OBJECT_GROUP:READ",
+	"OBJECT_GROUP:UPDATE",
+	"QUERY:CREATE",
+	"QUERY:DELETE",
+	"QUERY:READ",
+	"QUERY:UPDATE",
+	"QUERYPACK:CREATE",
+	"QUERYPACK:DELETE",
+	"QUERYPACK:READ",
+	"QUERYPACK:UPDATE",
+	"REGISTRY_PATH:CREATE",
+	"REGISTRY_PATH:DELETE",
+	"REGISTRY_PATH:READ",
+	"REGISTRY_PATH:UPDATE",
+	"REPORT:CREATE",
+	"REPORT:DELETE",
+	"REPORT:READ",
+	"REPORT:UPDATE",
+	"ROLE:CREATE",
+	"ROLE:DELETE",
+	"ROLE:READ",
+	"ROLE:UPDATE",
+	"TAG:CREATE",
+	"TAG:DELETE",
+	"TAG:READ",
+	"TAG:UPDATE",
+	"TAG_RULE:CREATE",
+	"TAG_RULE:DELETE",
+	"TAG_RULE:READ",
+	"TAG_RULE:UPDATE",
+	"USER:CREATE",
+	"USER:DELETE",
+	"USER:READ",
+	"USER:UPDATE",
+	"VULNERABILITY:CREATE",
+	"VULNERABILITY:DELETE",
+	"VULNERABILITY:READ",
+	"VULNERABILITY:UPDATE",
+	"YARA_GROUP_RULE:CREATE",
+	"YARA_GROUP_RULE:DELETE",
+	"YARA_GROUP_RULE:READ",
+	"YARA_GROUP_RULE:UPDATE",
+}
+
+// permissionCache holds the tenant permission catalogue for a provider
+// instance, so planning many roles only fetches it once.
+type permissionCache struct {
+	client      *uptycs.Client
+	once        sync.Once
+	permissions []string
+	fromTenant  bool
+}
+
+func newPermissionCache(client *uptycs.Client) *permissionCache {
+	return &permissionCache{
+		client: client,
+	}
+}
+
+// catalogue returns the permissions the tenant supports, falling back to
+// embeddedPermissions if the client isn't configured yet or the lookup fails.
+// The bool reports whether the tenant catalogue was used.
+func (c *permissionCache) catalogue(ctx context.Context) ([]string, bool) {
+	if c == nil || c.client == nil {
+		return embeddedPermissions, false
+	}
+
+	c.once.Do(func() {
+		permissionsResp, err := c.client.GetPermissions()
+		if err != nil {
+			tflog.Warn(ctx, "Could not fetch the tenant permission catalogue, using the built-in one", map[string]any{"error": err.Error()})
+			c.permissions = embeddedPermissions
+			return
+		}
+		if len(permissionsResp.Items) == 0 {
+			tflog.Warn(ctx, "The tenant permission catalogue is empty, using the built-in one")
+			c.permissions = embeddedPermissions
+			return
+		}
+
+		c.permissions = make([]string, 0, len(permissionsResp.Items))
+		for _, p := range permissionsResp.Items {
+			c.permissions = append(c.permissions, p.Name)
+		}
+		c.fromTenant = true
+	})
+	return c.permissions, c.fromTenant
+}
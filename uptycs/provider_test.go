@@ -29,7 +29,7 @@ resource "uptycs_alert_rule" "test" {
   description = "marcus test"
   enabled     = true
   grouping    = "MITRE"
-  grouping_l2 = "Impact"
+  grouping_l2 = "Collection"
   grouping_l3 = "T1560"
   sql_config = {
     interval_seconds : 3600,
@@ -56,3 +56,25 @@ resource "uptycs_alert_rule" "test" {
 		},
 	)
 }
+
+func TestProviderSettingsUpdateName(t *testing.T) {
+	tests := []struct {
+		name       string
+		prefix     string
+		remoteName string
+		want       string
+	}{
+		{name: "no prefix", prefix: "", remoteName: "rule", want: "rule"},
+		{name: "prefixed", prefix: "tf-", remoteName: "tf-rule", want: "tf-rule"},
+		{name: "created before the prefix was set", prefix: "tf-", remoteName: "rule", want: "rule"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := providerSettings{namePrefix: tt.prefix}
+			if got := s.updateName("rule", tt.remoteName); got != tt.want {
+				t.Errorf("updateName(%q, %q) = %q, want %q", "rule", tt.remoteName, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
 	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"strings"
 )
 
 func AlertRuleResource() resource.Resource {
@@ -17,7 +22,10 @@ func AlertRuleResource() resource.Resource {
 }
 
 type alertRuleResource struct {
-	client *uptycs.Client
+	client       *uptycs.Client
+	settings     providerSettings
+	exceptions   *exceptionCache
+	tableSchemas *tableSchemaCache
 }
 
 func (r *alertRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -30,7 +38,11 @@ func (r *alertRuleResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+	r.exceptions = data.exceptions
+	r.tableSchemas = data.tableSchemas
 }
 
 func (r *alertRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -73,10 +85,20 @@ func (r *alertRuleResource) Schema(_ context.Context, req resource.SchemaRequest
 			"notify_count":    schema.Int64Attribute{Required: true},
 			"rule_exceptions": schema.ListAttribute{
 				ElementType: types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Leave unset to manage the rule's exceptions with uptycs_alert_rule_exception_attachment instead. The two can't be combined on the same rule.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"destinations": schema.ListNestedAttribute{
-				Required: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "Leave unset to manage the rule's destinations with uptycs_alert_rule_destination instead. The two can't be combined on the same rule.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"severity":             schema.StringAttribute{Optional: true},
@@ -92,6 +114,21 @@ func (r *alertRuleResource) Schema(_ context.Context, req resource.SchemaRequest
 					"interval_seconds": schema.Int64Attribute{Optional: true},
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
+			"on_destroy": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("delete"),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"delete", "disable"}...),
+				},
+			},
 		},
 	}
 }
@@ -99,6 +136,17 @@ func (r *alertRuleResource) Schema(_ context.Context, req resource.SchemaRequest
 func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var alertRuleID string
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &alertRuleID)...)
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	var onDestroy types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_destroy"), &onDestroy)...)
+	if onDestroy.IsNull() {
+		onDestroy = types.StringValue("delete")
+	}
+	var alertTags types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("alert_tags"), &alertTags)...)
+	var tags []string
+	alertTags.ElementsAs(ctx, &tags, false)
 	alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{
 		ID: alertRuleID,
 	})
@@ -112,7 +160,7 @@ func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	var result = AlertRule{
 		ID:                  types.StringValue(alertRuleResp.ID),
-		Name:                types.StringValue(alertRuleResp.Name),
+		Name:                types.StringValue(strings.TrimPrefix(alertRuleResp.Name, r.settings.namePrefix)),
 		Description:         types.StringValue(alertRuleResp.Description),
 		Code:                types.StringValue(alertRuleResp.Code),
 		Type:                types.StringValue(alertRuleResp.Type),
@@ -123,18 +171,25 @@ func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		IsInternal:          types.BoolValue(alertRuleResp.IsInternal),
 		AlertNotifyCount:    types.Int64Value(int64(alertRuleResp.AlertNotifyCount)),
 		AlertNotifyInterval: types.Int64Value(int64(alertRuleResp.AlertNotifyInterval)),
-		AlertTags:           makeListStringAttribute(alertRuleResp.AlertTags),
+		AlertTags:           makeListStringAttribute(removeDefaultTags(alertRuleResp.AlertTags, r.settings.defaultAlertTags, tags)),
 		GroupingL2:          types.StringValue(alertRuleResp.GroupingL2),
 		GroupingL3:          types.StringValue(alertRuleResp.GroupingL3),
 		AlertRuleExceptions: makeListStringAttributeFn(alertRuleResp.AlertRuleExceptions, func(v uptycs.RuleException) (string, bool) { return v.ExceptionID, true }),
+		DeletionProtection:  types.BoolValue(deletionProtection.ValueBool()),
+		OnDestroy:           onDestroy,
 	}
 
 	// Only attempt to manage non-global rule exceptions
 	nonGlobalRuleExceptions := make([]attr.Value, 0)
 	for _, re := range alertRuleResp.AlertRuleExceptions {
-		_ruleException, _ := r.client.GetException(uptycs.Exception{
-			ID: re.ExceptionID,
-		})
+		_ruleException, err := r.exceptions.get(ctx, re.ExceptionID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading",
+				"Could not get exception with ID  "+re.ExceptionID+": "+err.Error(),
+			)
+			return
+		}
 		if !_ruleException.IsGlobal {
 			nonGlobalRuleExceptions = append(nonGlobalRuleExceptions, types.StringValue(_ruleException.ID))
 		}
@@ -147,16 +202,7 @@ func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		}
 	}
 
-	destinations := make([]AlertRuleDestination, 0)
-	for _, d := range alertRuleResp.Destinations {
-		destinations = append(destinations, AlertRuleDestination{
-			Severity:           types.StringValue(d.Severity),
-			DestinationID:      types.StringValue(d.DestinationID),
-			NotifyEveryAlert:   types.BoolValue(d.NotifyEveryAlert),
-			CloseAfterDelivery: types.BoolValue(d.CloseAfterDelivery),
-		})
-	}
-	result.Destinations = destinations
+	result.Destinations = makeAlertRuleDestinationsAttribute(ctx, alertRuleResp.Destinations, &resp.Diagnostics)
 
 	diags := resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
@@ -167,6 +213,10 @@ func (r *alertRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AlertRule
 	diags := req.Plan.Get(ctx, &plan)
@@ -175,6 +225,17 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetAlertRule(uptycs.AlertRule{Name: r.settings.namePrefix + plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "alertRule "+r.settings.namePrefix+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var tags []string
 	plan.AlertTags.ElementsAs(ctx, &tags, false)
 
@@ -187,18 +248,8 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 		})
 	}
 
-	_destinations := make([]uptycs.AlertRuleDestination, 0)
-	for _, d := range plan.Destinations {
-		_destinations = append(_destinations, uptycs.AlertRuleDestination{
-			Severity:           d.Severity.ValueString(),
-			DestinationID:      d.DestinationID.ValueString(),
-			NotifyEveryAlert:   d.NotifyEveryAlert.ValueBool(),
-			CloseAfterDelivery: d.CloseAfterDelivery.ValueBool(),
-		})
-	}
-
 	alertRule := uptycs.AlertRule{
-		Name:                plan.Name.ValueString(),
+		Name:                r.settings.namePrefix + plan.Name.ValueString(),
 		Description:         plan.Description.ValueString(),
 		Code:                plan.Code.ValueString(),
 		Type:                plan.Type.ValueString(),
@@ -207,11 +258,11 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 		Enabled:             plan.Enabled.ValueBool(),
 		Throttled:           plan.Throttled.ValueBool(),
 		IsInternal:          plan.IsInternal.ValueBool(),
-		AlertTags:           tags,
+		AlertTags:           addDefaultTags(tags, r.settings.defaultAlertTags),
 		GroupingL2:          plan.GroupingL2.ValueString(),
 		GroupingL3:          plan.GroupingL3.ValueString(),
 		AlertRuleExceptions: _ruleExceptions,
-		Destinations:        _destinations,
+		Destinations:        alertRuleDestinationsPayload(ctx, plan.Destinations, nil, &resp.Diagnostics),
 		AlertNotifyInterval: int(plan.AlertNotifyInterval.ValueInt64()),
 		AlertNotifyCount:    int(plan.AlertNotifyCount.ValueInt64()),
 	}
@@ -233,7 +284,7 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 
 	var result = AlertRule{
 		ID:                  types.StringValue(alertRuleResp.ID),
-		Name:                types.StringValue(alertRuleResp.Name),
+		Name:                types.StringValue(strings.TrimPrefix(alertRuleResp.Name, r.settings.namePrefix)),
 		Description:         types.StringValue(alertRuleResp.Description),
 		Code:                types.StringValue(alertRuleResp.Code),
 		Type:                types.StringValue(alertRuleResp.Type),
@@ -244,10 +295,12 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 		IsInternal:          types.BoolValue(alertRuleResp.IsInternal),
 		AlertNotifyCount:    types.Int64Value(int64(alertRuleResp.AlertNotifyCount)),
 		AlertNotifyInterval: types.Int64Value(int64(alertRuleResp.AlertNotifyInterval)),
-		AlertTags:           makeListStringAttribute(alertRuleResp.AlertTags),
+		AlertTags:           makeListStringAttribute(removeDefaultTags(alertRuleResp.AlertTags, r.settings.defaultAlertTags, tags)),
 		GroupingL2:          types.StringValue(alertRuleResp.GroupingL2),
 		GroupingL3:          types.StringValue(alertRuleResp.GroupingL3),
 		AlertRuleExceptions: makeListStringAttributeFn(alertRuleResp.AlertRuleExceptions, func(v uptycs.RuleException) (string, bool) { return v.ExceptionID, true }),
+		DeletionProtection:  plan.DeletionProtection,
+		OnDestroy:           plan.OnDestroy,
 	}
 
 	if alertRuleResp.SQLConfig != nil {
@@ -256,16 +309,7 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 		}
 	}
 
-	destinations := make([]AlertRuleDestination, 0)
-	for _, d := range alertRuleResp.Destinations {
-		destinations = append(destinations, AlertRuleDestination{
-			Severity:           types.StringValue(d.Severity),
-			DestinationID:      types.StringValue(d.DestinationID),
-			NotifyEveryAlert:   types.BoolValue(d.NotifyEveryAlert),
-			CloseAfterDelivery: types.BoolValue(d.CloseAfterDelivery),
-		})
-	}
-	result.Destinations = destinations
+	result.Destinations = makeAlertRuleDestinationsAttribute(ctx, alertRuleResp.Destinations, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
@@ -275,6 +319,10 @@ func (r *alertRuleResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state AlertRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -284,6 +332,14 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 
 	alertRuleID := state.ID.ValueString()
 
+	if state.IsInternal.ValueBool() && !r.settings.allowSystemObjectChanges {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Refusing to update internal alert rule "+state.Name.ValueString()+": it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to update it anyway.",
+		)
+		return
+	}
+
 	// Retrieve values from plan
 	var plan AlertRule
 	diags = req.Plan.Get(ctx, &plan)
@@ -295,70 +351,28 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 	var tags []string
 	plan.AlertTags.ElementsAs(ctx, &tags, false)
 
-	var ruleExceptions []string
-	plan.AlertRuleExceptions.ElementsAs(ctx, &ruleExceptions, false)
-
-	// Gather all the rule exceptions from the plan
-	// Note: this excludes global rule exceptions so you must gather those back at Update() time
-	alertRuleResp, _ := r.client.GetAlertRule(uptycs.AlertRule{ID: alertRuleID})
-	_ruleExceptions := make([]uptycs.RuleException, 0)
-	for _, _re := range ruleExceptions {
-		_ruleExceptions = append(_ruleExceptions, uptycs.RuleException{
-			ExceptionID: _re,
-		})
+	// Destinations and exceptions left out of the configuration are managed
+	// elsewhere, such as by uptycs_alert_rule_destination, and are sent back unchanged
+	var configDestinations types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("destinations"), &configDestinations)...)
+	if configDestinations.IsNull() {
+		plan.Destinations = types.ListNull(alertRuleDestinationType)
 	}
-
-	// Gather back the global rule exceptions so we dont remove them at Update time by leaving
-	// them out of .AlertRuleExceptions[]
-	for _, _re := range alertRuleResp.AlertRuleExceptions {
-		re, _ := r.client.GetException(uptycs.Exception{
-			ID: _re.ExceptionID,
-		})
-		if re.IsGlobal {
-			_ruleExceptions = append(_ruleExceptions, uptycs.RuleException{
-				ExceptionID: re.ID,
-			})
-		}
+	var configRuleExceptions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rule_exceptions"), &configRuleExceptions)...)
+	if configRuleExceptions.IsNull() {
+		plan.AlertRuleExceptions = types.ListNull(types.StringType)
 	}
 
-	_destinations := make([]uptycs.AlertRuleDestination, 0)
-	for _, d := range plan.Destinations {
-		_destinations = append(_destinations, uptycs.AlertRuleDestination{
-			Severity:           d.Severity.ValueString(),
-			DestinationID:      d.DestinationID.ValueString(),
-			NotifyEveryAlert:   d.NotifyEveryAlert.ValueBool(),
-			CloseAfterDelivery: d.CloseAfterDelivery.ValueBool(),
-		})
-	}
-
-	alertRule := uptycs.AlertRule{
-		ID:                  alertRuleID,
-		Name:                plan.Name.ValueString(),
-		Description:         plan.Description.ValueString(),
-		Code:                plan.Code.ValueString(),
-		Type:                plan.Type.ValueString(),
-		Rule:                plan.Rule.ValueString(),
-		Grouping:            plan.Grouping.ValueString(),
-		Enabled:             plan.Enabled.ValueBool(),
-		Throttled:           plan.Throttled.ValueBool(),
-		IsInternal:          plan.IsInternal.ValueBool(),
-		AlertNotifyInterval: int(plan.AlertNotifyInterval.ValueInt64()),
-		AlertNotifyCount:    int(plan.AlertNotifyCount.ValueInt64()),
-		AlertTags:           tags,
-		GroupingL2:          plan.GroupingL2.ValueString(),
-		GroupingL3:          plan.GroupingL3.ValueString(),
-		AlertRuleExceptions: _ruleExceptions,
-		Destinations:        _destinations,
-	}
-
-	if plan.SQLConfig != nil {
-		alertRule.SQLConfig = &uptycs.SQLConfig{
-			IntervalSeconds: int(plan.SQLConfig.IntervalSeconds.ValueInt64()),
-		}
+	alertRule, err := r.updatePayload(ctx, alertRuleID, plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not get alertRule with ID  "+alertRuleID+": "+err.Error(),
+		)
+		return
 	}
-
 	alertRuleResp, err := r.client.UpdateAlertRule(alertRule)
-
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating",
@@ -369,7 +383,7 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 
 	var result = AlertRule{
 		ID:                  types.StringValue(alertRuleResp.ID),
-		Name:                types.StringValue(alertRuleResp.Name),
+		Name:                types.StringValue(strings.TrimPrefix(alertRuleResp.Name, r.settings.namePrefix)),
 		Description:         types.StringValue(alertRuleResp.Description),
 		Code:                types.StringValue(alertRuleResp.Code),
 		Type:                types.StringValue(alertRuleResp.Type),
@@ -380,16 +394,23 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		IsInternal:          types.BoolValue(alertRuleResp.IsInternal),
 		AlertNotifyCount:    types.Int64Value(int64(alertRuleResp.AlertNotifyCount)),
 		AlertNotifyInterval: types.Int64Value(int64(alertRuleResp.AlertNotifyInterval)),
-		AlertTags:           makeListStringAttribute(alertRuleResp.AlertTags),
+		AlertTags:           makeListStringAttribute(removeDefaultTags(alertRuleResp.AlertTags, r.settings.defaultAlertTags, tags)),
 		GroupingL2:          types.StringValue(alertRuleResp.GroupingL2),
 		GroupingL3:          types.StringValue(alertRuleResp.GroupingL3),
+		DeletionProtection:  plan.DeletionProtection,
+		OnDestroy:           plan.OnDestroy,
 	}
 	// Only attempt to manage non-global rule exceptions
 	nonGlobalRuleExceptions := make([]attr.Value, 0)
 	for _, re := range alertRuleResp.AlertRuleExceptions {
-		_ruleException, _ := r.client.GetException(uptycs.Exception{
-			ID: re.ExceptionID,
-		})
+		_ruleException, err := r.exceptions.get(ctx, re.ExceptionID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating",
+				"Could not get exception with ID  "+re.ExceptionID+": "+err.Error(),
+			)
+			return
+		}
 		if !_ruleException.IsGlobal {
 			nonGlobalRuleExceptions = append(nonGlobalRuleExceptions, types.StringValue(_ruleException.ID))
 		}
@@ -402,16 +423,7 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 	}
 
-	destinations := make([]AlertRuleDestination, 0)
-	for _, d := range alertRuleResp.Destinations {
-		destinations = append(destinations, AlertRuleDestination{
-			Severity:           types.StringValue(d.Severity),
-			DestinationID:      types.StringValue(d.DestinationID),
-			NotifyEveryAlert:   types.BoolValue(d.NotifyEveryAlert),
-			CloseAfterDelivery: types.BoolValue(d.CloseAfterDelivery),
-		})
-	}
-	result.Destinations = destinations
+	result.Destinations = makeAlertRuleDestinationsAttribute(ctx, alertRuleResp.Destinations, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
@@ -420,7 +432,81 @@ func (r *alertRuleResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
+// updatePayload builds the alert rule the API expects on update from model,
+// which is the plan on update and the prior state when disabling on destroy.
+func (r *alertRuleResource) updatePayload(ctx context.Context, alertRuleID string, model AlertRule, diags *diag.Diagnostics) (uptycs.AlertRule, error) {
+	var tags []string
+	model.AlertTags.ElementsAs(ctx, &tags, false)
+
+	var ruleExceptions []string
+	model.AlertRuleExceptions.ElementsAs(ctx, &ruleExceptions, false)
+
+	// Gather all the rule exceptions from the plan
+	// Note: this excludes global rule exceptions so you must gather those back at Update() time
+	alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{ID: alertRuleID})
+	if err != nil {
+		return uptycs.AlertRule{}, err
+	}
+	_ruleExceptions := make([]uptycs.RuleException, 0)
+	if model.AlertRuleExceptions.IsNull() || model.AlertRuleExceptions.IsUnknown() {
+		// Exceptions that aren't managed here are sent back as they are
+		_ruleExceptions = append(_ruleExceptions, alertRuleResp.AlertRuleExceptions...)
+	} else {
+		for _, _re := range ruleExceptions {
+			_ruleExceptions = append(_ruleExceptions, uptycs.RuleException{
+				ExceptionID: _re,
+			})
+		}
+
+		// Gather back the global rule exceptions so we dont remove them at Update time by leaving
+		// them out of .AlertRuleExceptions[]
+		for _, _re := range alertRuleResp.AlertRuleExceptions {
+			re, err := r.exceptions.get(ctx, _re.ExceptionID)
+			if err != nil {
+				return uptycs.AlertRule{}, err
+			}
+			if re.IsGlobal {
+				_ruleExceptions = append(_ruleExceptions, uptycs.RuleException{
+					ExceptionID: re.ID,
+				})
+			}
+		}
+	}
+
+	alertRule := uptycs.AlertRule{
+		ID:                  alertRuleID,
+		Name:                r.settings.updateName(model.Name.ValueString(), alertRuleResp.Name),
+		Description:         model.Description.ValueString(),
+		Code:                model.Code.ValueString(),
+		Type:                model.Type.ValueString(),
+		Rule:                model.Rule.ValueString(),
+		Grouping:            model.Grouping.ValueString(),
+		Enabled:             model.Enabled.ValueBool(),
+		Throttled:           model.Throttled.ValueBool(),
+		IsInternal:          model.IsInternal.ValueBool(),
+		AlertNotifyInterval: int(model.AlertNotifyInterval.ValueInt64()),
+		AlertNotifyCount:    int(model.AlertNotifyCount.ValueInt64()),
+		AlertTags:           addDefaultTags(tags, r.settings.defaultAlertTags),
+		GroupingL2:          model.GroupingL2.ValueString(),
+		GroupingL3:          model.GroupingL3.ValueString(),
+		AlertRuleExceptions: _ruleExceptions,
+		Destinations:        alertRuleDestinationsPayload(ctx, model.Destinations, alertRuleResp.Destinations, diags),
+	}
+
+	if model.SQLConfig != nil {
+		alertRule.SQLConfig = &uptycs.SQLConfig{
+			IntervalSeconds: int(model.SQLConfig.IntervalSeconds.ValueInt64()),
+		}
+	}
+
+	return alertRule, nil
+}
+
 func (r *alertRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state AlertRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -428,8 +514,46 @@ func (r *alertRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting",
+			"Could not delete alert rule "+state.Name.ValueString()+": deletion_protection is enabled. Set deletion_protection = false and apply before destroying it.",
+		)
+		return
+	}
+
 	alertRuleID := state.ID.ValueString()
 
+	if state.IsInternal.ValueBool() && !r.settings.allowSystemObjectChanges {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Refusing to delete internal alert rule "+state.Name.ValueString()+": it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to delete it anyway.",
+		)
+		return
+	}
+
+	if state.OnDestroy.ValueString() == "disable" {
+		// Only flip enabled, and leave the destinations and exceptions as they are
+		state.Destinations = types.ListNull(alertRuleDestinationType)
+		state.AlertRuleExceptions = types.ListNull(types.StringType)
+		alertRule, err := r.updatePayload(ctx, alertRuleID, state, &resp.Diagnostics)
+		if err == nil {
+			alertRule.Enabled = false
+			_, err = r.client.UpdateAlertRule(alertRule)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting",
+				"Could not disable alert rule with ID  "+alertRuleID+": "+err.Error(),
+			)
+			return
+		}
+
+		// Leave the alert rule in place, disabled, and only remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	_, err := r.client.DeleteAlertRule(uptycs.AlertRule{
 		ID: alertRuleID,
 	})
@@ -446,5 +570,80 @@ func (r *alertRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *alertRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		return lookupPrefixedName(r.settings.namePrefix, name, func(name string) (string, error) {
+			alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{Name: name})
+			return alertRuleResp.ID, err
+		})
+	})
+}
+
+func (r *alertRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var ruleType, rule types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &ruleType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rule"), &rule)...)
+	if resp.Diagnostics.HasError() || rule.IsUnknown() || ruleType.ValueString() != "sql" {
+		return
+	}
+
+	changed := sqlChanged(ctx, req, path.Root("rule"), rule, &resp.Diagnostics)
+	validateSQL(ctx, r.tableSchemas, path.Root("rule"), rule.ValueString(), changed, &resp.Diagnostics)
+}
+
+func (r *alertRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateMITREGrouping(ctx, req.Config, &resp.Diagnostics)
+}
+
+var alertRuleDestinationType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"severity":             types.StringType,
+		"destination_id":       types.StringType,
+		"notify_every_alert":   types.BoolType,
+		"close_after_delivery": types.BoolType,
+	},
+}
+
+func makeAlertRuleDestinationsAttribute(ctx context.Context, destinations []uptycs.AlertRuleDestination, diags *diag.Diagnostics) types.List {
+	_destinations := make([]AlertRuleDestination, 0)
+	for _, d := range destinations {
+		_destinations = append(_destinations, AlertRuleDestination{
+			Severity:           types.StringValue(d.Severity),
+			DestinationID:      types.StringValue(d.DestinationID),
+			NotifyEveryAlert:   types.BoolValue(d.NotifyEveryAlert),
+			CloseAfterDelivery: types.BoolValue(d.CloseAfterDelivery),
+		})
+	}
+
+	list, d := types.ListValueFrom(ctx, alertRuleDestinationType, _destinations)
+	diags.Append(d...)
+	return list
+}
+
+// alertRuleDestinationsPayload maps the destinations from the plan into the objects the API expects.
+// When the list is not being managed (null or unknown), the current destinations are returned instead.
+func alertRuleDestinationsPayload(ctx context.Context, list types.List, current []uptycs.AlertRuleDestination, diags *diag.Diagnostics) []uptycs.AlertRuleDestination {
+	if list.IsNull() || list.IsUnknown() {
+		if current == nil {
+			return make([]uptycs.AlertRuleDestination, 0)
+		}
+		return current
+	}
+
+	var destinations []AlertRuleDestination
+	diags.Append(list.ElementsAs(ctx, &destinations, false)...)
+	_destinations := make([]uptycs.AlertRuleDestination, 0)
+	for _, d := range destinations {
+		_destinations = append(_destinations, uptycs.AlertRuleDestination{
+			Severity:           d.Severity.ValueString(),
+			DestinationID:      d.DestinationID.ValueString(),
+			NotifyEveryAlert:   d.NotifyEveryAlert.ValueBool(),
+			CloseAfterDelivery: d.CloseAfterDelivery.ValueBool(),
+		})
+	}
+	return _destinations
 }
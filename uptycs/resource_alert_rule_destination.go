@@ -0,0 +1,312 @@
+package uptycs
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"strings"
+	"sync"
+)
+
+// alertRuleLocks serialises the read-modify-write cycles that attachment
+// resources run against the same alert rule.
+var alertRuleLocks sync.Map
+
+func lockAlertRule(ruleID string) func() {
+	mu, _ := alertRuleLocks.LoadOrStore(ruleID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// checkAlertRuleWritable refuses changes to internal alert rules the same way
+// uptycs_alert_rule does, unless allow_system_object_changes is set.
+func checkAlertRuleWritable(alertRule uptycs.AlertRule, settings providerSettings) error {
+	if alertRule.IsInternal && !settings.allowSystemObjectChanges {
+		return fmt.Errorf("refusing to change internal alert rule %s: it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to change it anyway", alertRule.Name)
+	}
+	return nil
+}
+
+func AlertRuleDestinationResource() resource.Resource {
+	return &alertRuleDestinationResource{}
+}
+
+type alertRuleDestinationResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *alertRuleDestinationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rule_destination"
+}
+
+func (r *alertRuleDestinationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *alertRuleDestinationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_id": schema.StringAttribute{Required: true,
+				Description: "ID of the alert rule, or of the builder event rule, to attach the destination to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_id": schema.StringAttribute{Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"severity": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString(""),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notify_every_alert": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
+			"close_after_delivery": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
+		},
+	}
+}
+
+func alertRuleDestinationID(ruleID, destinationID, severity string) string {
+	return ruleID + "/" + destinationID + "/" + severity
+}
+
+func findAlertRuleDestination(destinations []uptycs.AlertRuleDestination, destinationID, severity string) int {
+	for i, d := range destinations {
+		if d.DestinationID == destinationID && d.Severity == severity {
+			return i
+		}
+	}
+	return -1
+}
+
+// attach adds the planned destination to the rule. On create it refuses a
+// destination the rule already routes to with the same severity, since that
+// one belongs to someone else, unless adopt_existing is set; on update it
+// changes it in place.
+func (r *alertRuleDestinationResource) attach(plan AlertRuleDestinationAttachment, create bool) error {
+	ruleID := plan.RuleID.ValueString()
+	defer lockAlertRule(ruleID)()
+
+	alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{
+		ID: ruleID,
+	})
+	if err != nil {
+		return err
+	}
+	if err := checkAlertRuleWritable(alertRuleResp, r.settings); err != nil {
+		return err
+	}
+
+	destination := uptycs.AlertRuleDestination{
+		Severity:           plan.Severity.ValueString(),
+		DestinationID:      plan.DestinationID.ValueString(),
+		NotifyEveryAlert:   plan.NotifyEveryAlert.ValueBool(),
+		CloseAfterDelivery: plan.CloseAfterDelivery.ValueBool(),
+	}
+	if i := findAlertRuleDestination(alertRuleResp.Destinations, destination.DestinationID, destination.Severity); i >= 0 {
+		if create && !r.settings.adoptExisting {
+			return fmt.Errorf("destination %s is already attached with severity %q. Import it, or set adopt_existing = true on the provider to take it over", destination.DestinationID, destination.Severity)
+		}
+		alertRuleResp.Destinations[i] = destination
+	} else {
+		alertRuleResp.Destinations = append(alertRuleResp.Destinations, destination)
+	}
+
+	_, err = r.client.UpdateAlertRule(alertRuleResp)
+	return err
+}
+
+func (r *alertRuleDestinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var attachmentID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &attachmentID)...)
+
+	parts := strings.SplitN(attachmentID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Invalid alert rule destination ID "+attachmentID+", expected <rule_id>/<destination_id>/<severity>",
+		)
+		return
+	}
+	ruleID, destinationID, severity := parts[0], parts[1], parts[2]
+
+	alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{
+		ID: ruleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	i := findAlertRuleDestination(alertRuleResp.Destinations, destinationID, severity)
+	if i < 0 {
+		// The destination was detached outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var result = AlertRuleDestinationAttachment{
+		ID:                 types.StringValue(attachmentID),
+		RuleID:             types.StringValue(ruleID),
+		DestinationID:      types.StringValue(destinationID),
+		Severity:           types.StringValue(severity),
+		NotifyEveryAlert:   types.BoolValue(alertRuleResp.Destinations[i].NotifyEveryAlert),
+		CloseAfterDelivery: types.BoolValue(alertRuleResp.Destinations[i].CloseAfterDelivery),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *alertRuleDestinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan AlertRuleDestinationAttachment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleID := plan.RuleID.ValueString()
+	if err := r.attach(plan, true); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not attach destination to alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(alertRuleDestinationID(ruleID, plan.DestinationID.ValueString(), plan.Severity.ValueString()))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *alertRuleDestinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan AlertRuleDestinationAttachment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleID := plan.RuleID.ValueString()
+	if err := r.attach(plan, false); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not update destination on alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *alertRuleDestinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state AlertRuleDestinationAttachment
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleID := state.RuleID.ValueString()
+	defer lockAlertRule(ruleID)()
+
+	alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{
+		ID: ruleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not get alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+	if err := checkAlertRuleWritable(alertRuleResp, r.settings); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not detach destination from alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	if i := findAlertRuleDestination(alertRuleResp.Destinations, state.DestinationID.ValueString(), state.Severity.ValueString()); i >= 0 {
+		alertRuleResp.Destinations = append(alertRuleResp.Destinations[:i], alertRuleResp.Destinations[i+1:]...)
+		_, err = r.client.UpdateAlertRule(alertRuleResp)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting",
+				"Could not detach destination from alertRule with ID  "+ruleID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *alertRuleDestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,248 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"strings"
+)
+
+func AlertRuleExceptionAttachmentResource() resource.Resource {
+	return &alertRuleExceptionAttachmentResource{}
+}
+
+type alertRuleExceptionAttachmentResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *alertRuleExceptionAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_rule_exception_attachment"
+}
+
+func (r *alertRuleExceptionAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *alertRuleExceptionAttachmentResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rule_id": schema.StringAttribute{Required: true,
+				Description: "ID of the alert rule, or of the builder event rule, to attach the exception to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exception_id": schema.StringAttribute{Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func findRuleException(exceptions []uptycs.RuleException, exceptionID string) int {
+	for i, e := range exceptions {
+		if e.ExceptionID == exceptionID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *alertRuleExceptionAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var attachmentID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &attachmentID)...)
+
+	ruleID, exceptionID, found := strings.Cut(attachmentID, "/")
+	if !found {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Invalid alert rule exception attachment ID "+attachmentID+", expected <rule_id>/<exception_id>",
+		)
+		return
+	}
+
+	alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{
+		ID: ruleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	if findRuleException(alertRuleResp.AlertRuleExceptions, exceptionID) < 0 {
+		// The exception was detached outside of terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var result = AlertRuleExceptionAttachment{
+		ID:          types.StringValue(attachmentID),
+		RuleID:      types.StringValue(ruleID),
+		ExceptionID: types.StringValue(exceptionID),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *alertRuleExceptionAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan AlertRuleExceptionAttachment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleID := plan.RuleID.ValueString()
+	exceptionID := plan.ExceptionID.ValueString()
+	defer lockAlertRule(ruleID)()
+
+	alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{
+		ID: ruleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not get alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+	if err := checkAlertRuleWritable(alertRuleResp, r.settings); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not attach exception to alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	if findRuleException(alertRuleResp.AlertRuleExceptions, exceptionID) >= 0 && !r.settings.adoptExisting {
+		// Someone else attached it, and deleting this resource would detach it
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Exception "+exceptionID+" is already attached to alertRule with ID  "+ruleID+". Import it, or set adopt_existing = true on the provider to take it over.",
+		)
+		return
+	}
+
+	if findRuleException(alertRuleResp.AlertRuleExceptions, exceptionID) < 0 {
+		alertRuleResp.AlertRuleExceptions = append(alertRuleResp.AlertRuleExceptions, uptycs.RuleException{
+			ExceptionID: exceptionID,
+		})
+		_, err = r.client.UpdateAlertRule(alertRuleResp)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating",
+				"Could not attach exception to alertRule with ID  "+ruleID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(ruleID + "/" + exceptionID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *alertRuleExceptionAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute forces replacement, so there is nothing to send
+	var plan AlertRuleExceptionAttachment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *alertRuleExceptionAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state AlertRuleExceptionAttachment
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleID := state.RuleID.ValueString()
+	defer lockAlertRule(ruleID)()
+
+	alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{
+		ID: ruleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not get alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+	if err := checkAlertRuleWritable(alertRuleResp, r.settings); err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not detach exception from alertRule with ID  "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	if i := findRuleException(alertRuleResp.AlertRuleExceptions, state.ExceptionID.ValueString()); i >= 0 {
+		alertRuleResp.AlertRuleExceptions = append(alertRuleResp.AlertRuleExceptions[:i], alertRuleResp.AlertRuleExceptions[i+1:]...)
+		_, err = r.client.UpdateAlertRule(alertRuleResp)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting",
+				"Could not detach exception from alertRule with ID  "+ruleID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *alertRuleExceptionAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,230 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func AuditGroupResource() resource.Resource {
+	return &auditGroupResource{}
+}
+
+type auditGroupResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *auditGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_group"
+}
+
+func (r *auditGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *auditGroupResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultString(""),
+				},
+			},
+			"audit_rules": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *auditGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var auditGroupID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &auditGroupID)...)
+	auditGroupResp, err := r.client.GetAuditGroup(uptycs.AuditGroup{
+		ID: auditGroupID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get auditGroup with ID  "+auditGroupID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = AuditGroup{
+		ID:          types.StringValue(auditGroupResp.ID),
+		Name:        types.StringValue(auditGroupResp.Name),
+		Description: types.StringValue(auditGroupResp.Description),
+		AuditRules:  makeListStringAttribute(auditGroupResp.AuditRules),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *auditGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan AuditGroup
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetAuditGroup(uptycs.AuditGroup{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "auditGroup "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var auditRules []string
+	plan.AuditRules.ElementsAs(ctx, &auditRules, false)
+
+	auditGroupResp, err := r.client.CreateAuditGroup(uptycs.AuditGroup{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		AuditRules:  auditRules,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not create auditGroup, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = AuditGroup{
+		ID:          types.StringValue(auditGroupResp.ID),
+		Name:        types.StringValue(auditGroupResp.Name),
+		Description: types.StringValue(auditGroupResp.Description),
+		AuditRules:  makeListStringAttribute(auditGroupResp.AuditRules),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *auditGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
+	var state AuditGroup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auditGroupID := state.ID.ValueString()
+
+	// Retrieve values from plan
+	var plan AuditGroup
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var auditRules []string
+	plan.AuditRules.ElementsAs(ctx, &auditRules, false)
+
+	auditGroupResp, err := r.client.UpdateAuditGroup(uptycs.AuditGroup{
+		ID:          auditGroupID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		AuditRules:  auditRules,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not update auditGroup, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = AuditGroup{
+		ID:          types.StringValue(auditGroupResp.ID),
+		Name:        types.StringValue(auditGroupResp.Name),
+		Description: types.StringValue(auditGroupResp.Description),
+		AuditRules:  makeListStringAttribute(auditGroupResp.AuditRules),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *auditGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state AuditGroup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auditGroupID := state.ID.ValueString()
+
+	_, err := r.client.DeleteAuditGroup(uptycs.AuditGroup{
+		ID: auditGroupID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not delete auditGroup with ID  "+auditGroupID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *auditGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		auditGroupResp, err := r.client.GetAuditGroup(uptycs.AuditGroup{Name: name})
+		return auditGroupResp.ID, err
+	})
+}
@@ -0,0 +1,234 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func AuditRuleResource() resource.Resource {
+	return &auditRuleResource{}
+}
+
+type auditRuleResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *auditRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_rule"
+}
+
+func (r *auditRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *auditRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultString(""),
+				},
+			},
+			"rule": schema.StringAttribute{Required: true},
+			"enabled": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultBool(true),
+				},
+			},
+		},
+	}
+}
+
+func (r *auditRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var auditRuleID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &auditRuleID)...)
+	auditRuleResp, err := r.client.GetAuditRule(uptycs.AuditRule{
+		ID: auditRuleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get auditRule with ID  "+auditRuleID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = AuditRule{
+		ID:          types.StringValue(auditRuleResp.ID),
+		Name:        types.StringValue(auditRuleResp.Name),
+		Description: types.StringValue(auditRuleResp.Description),
+		Rule:        types.StringValue(auditRuleResp.Rule),
+		Enabled:     types.BoolValue(auditRuleResp.Enabled),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *auditRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan AuditRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetAuditRule(uptycs.AuditRule{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "auditRule "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	auditRuleResp, err := r.client.CreateAuditRule(uptycs.AuditRule{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Rule:        plan.Rule.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not create auditRule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = AuditRule{
+		ID:          types.StringValue(auditRuleResp.ID),
+		Name:        types.StringValue(auditRuleResp.Name),
+		Description: types.StringValue(auditRuleResp.Description),
+		Rule:        types.StringValue(auditRuleResp.Rule),
+		Enabled:     types.BoolValue(auditRuleResp.Enabled),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *auditRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
+	var state AuditRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auditRuleID := state.ID.ValueString()
+
+	// Retrieve values from plan
+	var plan AuditRule
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auditRuleResp, err := r.client.UpdateAuditRule(uptycs.AuditRule{
+		ID:          auditRuleID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Rule:        plan.Rule.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not update auditRule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = AuditRule{
+		ID:          types.StringValue(auditRuleResp.ID),
+		Name:        types.StringValue(auditRuleResp.Name),
+		Description: types.StringValue(auditRuleResp.Description),
+		Rule:        types.StringValue(auditRuleResp.Rule),
+		Enabled:     types.BoolValue(auditRuleResp.Enabled),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *auditRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state AuditRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	auditRuleID := state.ID.ValueString()
+
+	_, err := r.client.DeleteAuditRule(uptycs.AuditRule{
+		ID: auditRuleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not delete auditRule with ID  "+auditRuleID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *auditRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		auditRuleResp, err := r.client.GetAuditRule(uptycs.AuditRule{Name: name})
+		return auditRuleResp.ID, err
+	})
+}
@@ -14,7 +14,8 @@ func ComplianceProfileResource() resource.Resource {
 }
 
 type complianceProfileResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *complianceProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -26,7 +27,9 @@ func (r *complianceProfileResource) Configure(_ context.Context, req resource.Co
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *complianceProfileResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -70,6 +73,10 @@ func (r *complianceProfileResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *complianceProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan ComplianceProfile
 	diags := req.Plan.Get(ctx, &plan)
@@ -78,6 +85,17 @@ func (r *complianceProfileResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetComplianceProfile(uptycs.ComplianceProfile{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "complianceProfile "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	complianceProfileResp, err := r.client.CreateComplianceProfile(uptycs.ComplianceProfile{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -107,6 +125,10 @@ func (r *complianceProfileResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *complianceProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state ComplianceProfile
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -154,6 +176,10 @@ func (r *complianceProfileResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *complianceProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state ComplianceProfile
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -178,5 +204,8 @@ func (r *complianceProfileResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *complianceProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		complianceProfileResp, err := r.client.GetComplianceProfile(uptycs.ComplianceProfile{Name: name})
+		return complianceProfileResp.ID, err
+	})
 }
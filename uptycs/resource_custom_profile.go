@@ -16,7 +16,8 @@ func CustomProfileResource() resource.Resource {
 }
 
 type customProfileResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *customProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -28,7 +29,9 @@ func (r *customProfileResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *customProfileResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -81,6 +84,10 @@ func (r *customProfileResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *customProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan CustomProfile
 	diags := req.Plan.Get(ctx, &plan)
@@ -89,6 +96,17 @@ func (r *customProfileResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetCustomProfile(uptycs.CustomProfile{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "customProfile "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	customProfileResp, err := r.client.CreateCustomProfile(uptycs.CustomProfile{
 		Name:           plan.Name.ValueString(),
 		Description:    plan.Description.ValueString(),
@@ -127,6 +145,10 @@ func (r *customProfileResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *customProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state CustomProfile
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -183,6 +205,10 @@ func (r *customProfileResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *customProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state CustomProfile
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -207,5 +233,8 @@ func (r *customProfileResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *customProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		customProfileResp, err := r.client.GetCustomProfile(uptycs.CustomProfile{Name: name})
+		return customProfileResp.ID, err
+	})
 }
@@ -21,7 +21,8 @@ func DestinationResource() resource.Resource {
 }
 
 type destinationResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *destinationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -33,7 +34,9 @@ func (r *destinationResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *destinationResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -98,6 +101,12 @@ func (r *destinationResource) Schema(_ context.Context, req resource.SchemaReque
 				},
 			},
 			"template": schema.StringAttribute{Optional: true},
+			"deletion_protection": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
 		},
 	}
 }
@@ -105,6 +114,8 @@ func (r *destinationResource) Schema(_ context.Context, req resource.SchemaReque
 func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var destinationID string
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &destinationID)...)
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
 	destinationResp, err := r.client.GetDestination(uptycs.Destination{
 		ID: destinationID,
 	})
@@ -137,7 +148,8 @@ func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest
 			SlackAttachment: types.BoolValue(destinationResp.Config.SlackAttachment),
 			Headers:         types.StringValue(string(headersJSON) + "\n"),
 		},
-		Template: types.StringValue(destinationResp.Template.Template),
+		Template:           types.StringValue(destinationResp.Template.Template),
+		DeletionProtection: types.BoolValue(deletionProtection.ValueBool()),
 	}
 
 	diags := resp.State.Set(ctx, result)
@@ -149,6 +161,10 @@ func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *destinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan Destination
 	diags := req.Plan.Get(ctx, &plan)
@@ -157,6 +173,17 @@ func (r *destinationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetDestination(uptycs.Destination{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "destination "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	destinationResp, err := r.client.CreateDestination(uptycs.Destination{
 		Name:    plan.Name.ValueString(),
 		Type:    plan.Type.ValueString(),
@@ -208,7 +235,8 @@ func (r *destinationResource) Create(ctx context.Context, req resource.CreateReq
 			SlackAttachment: types.BoolValue(destinationResp.Config.SlackAttachment),
 			Headers:         types.StringValue(string(headersJSON) + "\n"),
 		},
-		Template: types.StringValue(destinationResp.Template.Template),
+		Template:           types.StringValue(destinationResp.Template.Template),
+		DeletionProtection: plan.DeletionProtection,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -219,6 +247,10 @@ func (r *destinationResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *destinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state Destination
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -292,7 +324,8 @@ func (r *destinationResource) Update(ctx context.Context, req resource.UpdateReq
 			SlackAttachment: types.BoolValue(destinationResp.Config.SlackAttachment),
 			Headers:         types.StringValue(string(headersJSON) + "\n"),
 		},
-		Template: types.StringValue(destinationResp.Template.Template),
+		Template:           types.StringValue(destinationResp.Template.Template),
+		DeletionProtection: plan.DeletionProtection,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -303,6 +336,10 @@ func (r *destinationResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state Destination
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -310,6 +347,14 @@ func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting",
+			"Could not delete destination "+state.Name.ValueString()+": deletion_protection is enabled. Set deletion_protection = false and apply before destroying it.",
+		)
+		return
+	}
+
 	destinationID := state.ID.ValueString()
 
 	_, err := r.client.DeleteDestination(uptycs.Destination{
@@ -327,5 +372,8 @@ func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *destinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		destinationResp, err := r.client.GetDestination(uptycs.Destination{Name: name})
+		return destinationResp.ID, err
+	})
 }
@@ -16,7 +16,8 @@ func EventExcludeProfileResource() resource.Resource {
 }
 
 type eventExcludeProfileResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *eventExcludeProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -28,7 +29,9 @@ func (r *eventExcludeProfileResource) Configure(_ context.Context, req resource.
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *eventExcludeProfileResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -46,6 +49,10 @@ func (r *eventExcludeProfileResource) Schema(_ context.Context, req resource.Sch
 }
 
 func (r *eventExcludeProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan EventExcludeProfile
 	diags := req.Plan.Get(ctx, &plan)
@@ -54,6 +61,17 @@ func (r *eventExcludeProfileResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetEventExcludeProfile(uptycs.EventExcludeProfile{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "eventExcludeProfile "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	eventExcludeProfileResp, err := r.client.CreateEventExcludeProfile(uptycs.EventExcludeProfile{
 		Name:         plan.Name.ValueString(),
 		Description:  plan.Description.ValueString(),
@@ -131,6 +149,10 @@ func (r *eventExcludeProfileResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *eventExcludeProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state EventExcludeProfile
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -189,6 +211,10 @@ func (r *eventExcludeProfileResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *eventExcludeProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state EventExcludeProfile
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -215,5 +241,8 @@ func (r *eventExcludeProfileResource) Delete(ctx context.Context, req resource.D
 
 // Import resource
 func (r eventExcludeProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		eventExcludeProfileResp, err := r.client.GetEventExcludeProfile(uptycs.EventExcludeProfile{Name: name})
+		return eventExcludeProfileResp.ID, err
+	})
 }
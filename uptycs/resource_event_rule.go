@@ -3,7 +3,9 @@ package uptycs
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,9 +14,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
 	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"strings"
 	"time"
 )
 
@@ -23,7 +27,10 @@ func EventRuleResource() resource.Resource {
 }
 
 type eventRuleResource struct {
-	client *uptycs.Client
+	client       *uptycs.Client
+	settings     providerSettings
+	exceptions   *exceptionCache
+	tableSchemas *tableSchemaCache
 }
 
 func (r *eventRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +42,11 @@ func (r *eventRuleResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+	r.exceptions = data.exceptions
+	r.tableSchemas = data.tableSchemas
 }
 
 func (r *eventRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -116,7 +127,8 @@ func (r *eventRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"alert_rule": schema.SingleNestedAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "Leave unset to manage the attached alert rule's destinations and exceptions with uptycs_alert_rule_destination and uptycs_alert_rule_exception_attachment instead.",
 				Attributes: map[string]schema.Attribute{
 					"rule_exceptions": schema.ListAttribute{
 						ElementType: types.StringType,
@@ -141,6 +153,21 @@ func (r *eventRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
+			"on_destroy": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("delete"),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"delete", "disable"}...),
+				},
+			},
 		},
 	}
 }
@@ -148,6 +175,19 @@ func (r *eventRuleResource) Schema(_ context.Context, _ resource.SchemaRequest,
 func (r *eventRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var eventRuleID string
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &eventRuleID)...)
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	var onDestroy types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_destroy"), &onDestroy)...)
+	if onDestroy.IsNull() {
+		onDestroy = types.StringValue("delete")
+	}
+	var eventTags types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("event_tags"), &eventTags)...)
+	var tags []string
+	eventTags.ElementsAs(ctx, &tags, false)
+	var alertRule types.Object
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("alert_rule"), &alertRule)...)
 	eventRuleResp, err := r.client.GetEventRule(uptycs.EventRule{
 		ID: eventRuleID,
 	})
@@ -160,18 +200,20 @@ func (r *eventRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	var result = EventRule{
-		ID:          types.StringValue(eventRuleResp.ID),
-		Enabled:     types.BoolValue(eventRuleResp.Enabled),
-		Name:        types.StringValue(eventRuleResp.Name),
-		Description: types.StringValue(eventRuleResp.Description),
-		Code:        types.StringValue(eventRuleResp.Code),
-		Type:        types.StringValue(eventRuleResp.Type),
-		Rule:        types.StringValue(eventRuleResp.Rule),
-		Grouping:    types.StringValue(eventRuleResp.Grouping),
-		GroupingL2:  types.StringValue(eventRuleResp.GroupingL2),
-		GroupingL3:  types.StringValue(eventRuleResp.GroupingL3),
-		Score:       types.StringValue(eventRuleResp.Score),
-		EventTags:   makeListStringAttribute(eventRuleResp.EventTags),
+		ID:                 types.StringValue(eventRuleResp.ID),
+		Enabled:            types.BoolValue(eventRuleResp.Enabled),
+		Name:               types.StringValue(strings.TrimPrefix(eventRuleResp.Name, r.settings.namePrefix)),
+		Description:        types.StringValue(eventRuleResp.Description),
+		Code:               types.StringValue(eventRuleResp.Code),
+		Type:               types.StringValue(eventRuleResp.Type),
+		Rule:               types.StringValue(eventRuleResp.Rule),
+		Grouping:           types.StringValue(eventRuleResp.Grouping),
+		GroupingL2:         types.StringValue(eventRuleResp.GroupingL2),
+		GroupingL3:         types.StringValue(eventRuleResp.GroupingL3),
+		Score:              types.StringValue(eventRuleResp.Score),
+		EventTags:          makeListStringAttribute(removeDefaultTags(eventRuleResp.EventTags, r.settings.defaultEventTags, tags)),
+		DeletionProtection: types.BoolValue(deletionProtection.ValueBool()),
+		OnDestroy:          onDestroy,
 	}
 	if eventRuleResp.Type == "builder" {
 		filtersJSON, err := json.MarshalIndent(eventRuleResp.BuilderConfig.Filters, "", "  ")
@@ -198,18 +240,27 @@ func (r *eventRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 			},
 		}
 
-		result.AlertRule = &AlertRuleLite{
-			AlertRuleExceptions: makeListStringAttributeFn([]string{}, func(v string) (string, bool) { return v, true }),
-			Destinations:        []AlertRuleDestination{},
+		// Only read back the attached alert rule when alert_rule is managed here,
+		// so attachment resources don't show up as drift
+		if !alertRule.IsNull() {
+			result.AlertRule = &AlertRuleLite{
+				AlertRuleExceptions: makeListStringAttributeFn([]string{}, func(v string) (string, bool) { return v, true }),
+				Destinations:        []AlertRuleDestination{},
+			}
 		}
-		if eventRuleResp.BuilderConfig.AutoAlertConfig.RaiseAlert {
+		if !alertRule.IsNull() && eventRuleResp.BuilderConfig.AutoAlertConfig.RaiseAlert {
 			alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{ID: eventRuleResp.ID})
 			if err == nil {
 				nonGlobalRuleExceptions := make([]attr.Value, 0)
 				for _, re := range alertRuleResp.AlertRuleExceptions {
-					_ruleException, _ := r.client.GetException(uptycs.Exception{
-						ID: re.ExceptionID,
-					})
+					_ruleException, err := r.exceptions.get(ctx, re.ExceptionID)
+					if err != nil {
+						resp.Diagnostics.AddError(
+							"Error reading",
+							"Could not get exception with ID  "+re.ExceptionID+": "+err.Error(),
+						)
+						return
+					}
 					if !_ruleException.IsGlobal {
 						nonGlobalRuleExceptions = append(nonGlobalRuleExceptions, types.StringValue(_ruleException.ID))
 					}
@@ -246,6 +297,10 @@ func (r *eventRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan EventRule
 	diags := req.Plan.Get(ctx, &plan)
@@ -254,6 +309,17 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetEventRule(uptycs.EventRule{Name: r.settings.namePrefix + plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "eventRule "+r.settings.namePrefix+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var tags []string
 	plan.EventTags.ElementsAs(ctx, &tags, false)
 
@@ -264,7 +330,7 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 	if plan.Type.ValueString() == "builder" {
 
 		eventRuleResp, err = r.client.CreateEventRule(uptycs.EventRule{
-			Name:        plan.Name.ValueString(),
+			Name:        r.settings.namePrefix + plan.Name.ValueString(),
 			Code:        plan.Code.ValueString(),
 			Description: plan.Description.ValueString(),
 			Rule:        plan.Rule.ValueString(),
@@ -273,7 +339,7 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 			Grouping:    plan.Grouping.ValueString(),
 			GroupingL2:  plan.GroupingL2.ValueString(),
 			GroupingL3:  plan.GroupingL3.ValueString(),
-			EventTags:   tags,
+			EventTags:   addDefaultTags(tags, r.settings.defaultEventTags),
 			Score:       plan.Score.ValueString(),
 			BuilderConfig: &uptycs.BuilderConfig{
 				Filters:       uptycs.CustomJSONString(plan.BuilderConfig.Filters.ValueString()),
@@ -319,7 +385,7 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 		result = EventRule{
 			ID:          types.StringValue(eventRuleResp.ID),
 			Enabled:     types.BoolValue(eventRuleResp.Enabled),
-			Name:        types.StringValue(eventRuleResp.Name),
+			Name:        types.StringValue(strings.TrimPrefix(eventRuleResp.Name, r.settings.namePrefix)),
 			Description: types.StringValue(eventRuleResp.Description),
 			Code:        types.StringValue(eventRuleResp.Code),
 			Type:        types.StringValue(eventRuleResp.Type),
@@ -328,7 +394,7 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 			GroupingL2:  types.StringValue(eventRuleResp.GroupingL2),
 			GroupingL3:  types.StringValue(eventRuleResp.GroupingL3),
 			Score:       types.StringValue(eventRuleResp.Score),
-			EventTags:   makeListStringAttribute(eventRuleResp.EventTags),
+			EventTags:   makeListStringAttribute(removeDefaultTags(eventRuleResp.EventTags, r.settings.defaultEventTags, tags)),
 			BuilderConfig: &BuilderConfig{
 				Filters:       types.StringValue(string(filtersJSON) + "\n"),
 				TableName:     types.StringValue(eventRuleResp.BuilderConfig.TableName),
@@ -343,10 +409,9 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 					MetadataSources: types.StringValue(string(metadataJSON) + "\n"),
 				},
 			},
-			AlertRule: &AlertRuleLite{
-				AlertRuleExceptions: makeListStringAttributeFn([]string{}, func(v string) (string, bool) { return v, true }),
-				Destinations:        []AlertRuleDestination{},
-			},
+			AlertRule:          plan.AlertRule,
+			DeletionProtection: plan.DeletionProtection,
+			OnDestroy:          plan.OnDestroy,
 		}
 
 		if err != nil {
@@ -358,7 +423,7 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 		}
 	} else {
 		eventRuleResp, err := r.client.CreateEventRule(uptycs.EventRule{
-			Name:        plan.Name.ValueString(),
+			Name:        r.settings.namePrefix + plan.Name.ValueString(),
 			Code:        plan.Code.ValueString(),
 			Description: plan.Description.ValueString(),
 			Rule:        plan.Rule.ValueString(),
@@ -367,7 +432,7 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 			Grouping:    plan.Grouping.ValueString(),
 			GroupingL2:  plan.GroupingL2.ValueString(),
 			GroupingL3:  plan.GroupingL3.ValueString(),
-			EventTags:   tags,
+			EventTags:   addDefaultTags(tags, r.settings.defaultEventTags),
 			Score:       plan.Score.ValueString(),
 			SQLConfig: &uptycs.SQLConfig{
 				IntervalSeconds: int(plan.SQLConfig.IntervalSeconds.ValueInt64()),
@@ -384,7 +449,7 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 		result = EventRule{
 			ID:          types.StringValue(eventRuleResp.ID),
 			Enabled:     types.BoolValue(eventRuleResp.Enabled),
-			Name:        types.StringValue(eventRuleResp.Name),
+			Name:        types.StringValue(strings.TrimPrefix(eventRuleResp.Name, r.settings.namePrefix)),
 			Description: types.StringValue(eventRuleResp.Description),
 			Code:        types.StringValue(eventRuleResp.Code),
 			Type:        types.StringValue(eventRuleResp.Type),
@@ -392,41 +457,34 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 			GroupingL2:  types.StringValue(eventRuleResp.GroupingL2),
 			GroupingL3:  types.StringValue(eventRuleResp.GroupingL3),
 			Score:       types.StringValue(eventRuleResp.Score),
-			EventTags:   makeListStringAttribute(eventRuleResp.EventTags),
+			EventTags:   makeListStringAttribute(removeDefaultTags(eventRuleResp.EventTags, r.settings.defaultEventTags, tags)),
 			Rule:        types.StringValue(eventRuleResp.Rule),
 			SQLConfig: &SQLConfig{
 				IntervalSeconds: types.Int64Value(int64(eventRuleResp.SQLConfig.IntervalSeconds)),
 			},
+			DeletionProtection: plan.DeletionProtection,
+			OnDestroy:          plan.OnDestroy,
 		}
 	}
 
 	time.Sleep(1 * time.Second)
 
 	if eventRuleResp.Type == "builder" {
-		if !plan.BuilderConfig.AutoAlertConfig.RaiseAlert.ValueBool() {
-			if len(plan.AlertRule.Destinations) > 0 || len(plan.AlertRule.AlertRuleExceptions.Elements()) > 0 {
-				_, _ = r.client.DeleteEventRule(uptycs.EventRule{ID: eventRuleResp.ID})
-				resp.Diagnostics.AddError(
-					"Error creating",
-					"alert_rule.destinations and alert_rule.rule_exceptions must be empty when builder_config.auto_alert_config.raise_alert is false",
-				)
-				return
-			}
+		alertRulePlan, err := builderAlertRule(plan)
+		if err != nil {
+			_, _ = r.client.DeleteEventRule(uptycs.EventRule{ID: eventRuleResp.ID})
+			resp.Diagnostics.AddError(
+				"Error creating",
+				err.Error(),
+			)
+			return
+		}
 
-		} else {
+		if alertRulePlan != nil {
 
 			alertRuleResp, err := r.client.GetAlertRule(uptycs.AlertRule{ID: eventRuleResp.ID})
 			if err == nil {
 
-				if plan.AlertRule == nil {
-					resp.Diagnostics.AddError(
-						"Error creating",
-						"Could not manage attached alertRule. alert_rule = { destinations = [], rule_exceptions = [] } is required at a minimum for event rules with type 'builder'",
-					)
-					_, _ = r.client.DeleteEventRule(uptycs.EventRule{ID: eventRuleResp.ID})
-					return
-				}
-
 				var ruleExceptions []string
 				plan.AlertRule.AlertRuleExceptions.ElementsAs(ctx, &ruleExceptions, false)
 
@@ -440,10 +498,15 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 				}
 				// Gather back the global rule exceptions, so we don't remove them at Update time by leaving
 				// them out of .AlertRuleExceptions[]
-				for _, _re := range eventRuleResp.Exceptions {
-					re, _ := r.client.GetException(uptycs.Exception{
-						ID: _re.ExceptionID,
-					})
+				for _, _re := range alertRuleResp.AlertRuleExceptions {
+					re, err := r.exceptions.get(ctx, _re.ExceptionID)
+					if err != nil {
+						resp.Diagnostics.AddError(
+							"Error creating",
+							"Could not get exception with ID  "+_re.ExceptionID+": "+err.Error(),
+						)
+						return
+					}
 					if re.IsGlobal {
 						_ruleExceptions = append(_ruleExceptions, uptycs.RuleException{
 							ExceptionID: re.ID,
@@ -485,15 +548,22 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 
 				nonGlobalRuleExceptions := make([]attr.Value, 0)
 				for _, re := range alertRuleResp.AlertRuleExceptions {
-					_ruleException, _ := r.client.GetException(uptycs.Exception{
-						ID: re.ExceptionID,
-					})
+					_ruleException, err := r.exceptions.get(ctx, re.ExceptionID)
+					if err != nil {
+						resp.Diagnostics.AddError(
+							"Error creating",
+							"Could not get exception with ID  "+re.ExceptionID+": "+err.Error(),
+						)
+						return
+					}
 					if !_ruleException.IsGlobal {
 						nonGlobalRuleExceptions = append(nonGlobalRuleExceptions, types.StringValue(_ruleException.ID))
 					}
 				}
-				result.AlertRule.AlertRuleExceptions = types.ListValueMust(types.StringType, nonGlobalRuleExceptions)
-				result.AlertRule.Destinations = plan.AlertRule.Destinations
+				result.AlertRule = &AlertRuleLite{
+					AlertRuleExceptions: types.ListValueMust(types.StringType, nonGlobalRuleExceptions),
+					Destinations:        plan.AlertRule.Destinations,
+				}
 			} else {
 				resp.Diagnostics.AddError(
 					"Error creating",
@@ -527,6 +597,10 @@ func (r *eventRuleResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state EventRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -547,39 +621,21 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 	var tags []string
 	plan.EventTags.ElementsAs(ctx, &tags, false)
 
+	eventRule, err := r.updatePayload(ctx, eventRuleID, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not get eventRule with ID  "+eventRuleID+": "+err.Error(),
+		)
+		return
+	}
+
 	var eventRuleResp = uptycs.EventRule{}
 	var result = EventRule{}
 
 	if plan.Type.ValueString() == "builder" {
 
-		eventRuleResp, _ = r.client.UpdateEventRule(uptycs.EventRule{
-			ID:          eventRuleID,
-			Name:        plan.Name.ValueString(),
-			Code:        plan.Code.ValueString(),
-			Description: plan.Description.ValueString(),
-			Rule:        plan.Rule.ValueString(),
-			Type:        plan.Type.ValueString(),
-			Enabled:     plan.Enabled.ValueBool(),
-			Grouping:    plan.Grouping.ValueString(),
-			GroupingL2:  plan.GroupingL2.ValueString(),
-			GroupingL3:  plan.GroupingL3.ValueString(),
-			EventTags:   tags,
-			Score:       plan.Score.ValueString(),
-			BuilderConfig: &uptycs.BuilderConfig{
-				Filters:       uptycs.CustomJSONString(plan.BuilderConfig.Filters.ValueString()),
-				TableName:     plan.BuilderConfig.TableName.ValueString(),
-				Added:         plan.BuilderConfig.Added.ValueBool(),
-				MatchesFilter: plan.BuilderConfig.MatchesFilter.ValueBool(),
-				Severity:      plan.BuilderConfig.Severity.ValueString(),
-				Key:           plan.BuilderConfig.Key.ValueString(),
-				ValueField:    plan.BuilderConfig.ValueField.ValueString(),
-				AutoAlertConfig: uptycs.AutoAlertConfig{
-					DisableAlert:    plan.BuilderConfig.AutoAlertConfig.DisableAlert.ValueBool(),
-					RaiseAlert:      plan.BuilderConfig.AutoAlertConfig.RaiseAlert.ValueBool(),
-					MetadataSources: uptycs.CustomJSONString(plan.BuilderConfig.AutoAlertConfig.MetadataSources.ValueString()),
-				},
-			},
-		})
+		eventRuleResp, _ = r.client.UpdateEventRule(eventRule)
 		filtersJSON, err := json.MarshalIndent(eventRuleResp.BuilderConfig.Filters, "", "  ")
 		if err != nil {
 			fmt.Println(err)
@@ -593,7 +649,7 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		result = EventRule{
 			ID:          types.StringValue(eventRuleResp.ID),
 			Enabled:     types.BoolValue(eventRuleResp.Enabled),
-			Name:        types.StringValue(eventRuleResp.Name),
+			Name:        types.StringValue(strings.TrimPrefix(eventRuleResp.Name, r.settings.namePrefix)),
 			Description: types.StringValue(eventRuleResp.Description),
 			Code:        types.StringValue(eventRuleResp.Code),
 			Type:        types.StringValue(eventRuleResp.Type),
@@ -602,7 +658,7 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 			GroupingL2:  types.StringValue(eventRuleResp.GroupingL2),
 			GroupingL3:  types.StringValue(eventRuleResp.GroupingL3),
 			Score:       types.StringValue(eventRuleResp.Score),
-			EventTags:   makeListStringAttribute(eventRuleResp.EventTags),
+			EventTags:   makeListStringAttribute(removeDefaultTags(eventRuleResp.EventTags, r.settings.defaultEventTags, tags)),
 			BuilderConfig: &BuilderConfig{
 				Filters:       types.StringValue(string(filtersJSON) + "\n"),
 				TableName:     types.StringValue(eventRuleResp.BuilderConfig.TableName),
@@ -617,10 +673,9 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 					MetadataSources: types.StringValue(string(metadataJSON) + "\n"),
 				},
 			},
-			AlertRule: &AlertRuleLite{
-				AlertRuleExceptions: makeListStringAttributeFn([]string{}, func(v string) (string, bool) { return v, true }),
-				Destinations:        []AlertRuleDestination{},
-			},
+			AlertRule:          plan.AlertRule,
+			DeletionProtection: plan.DeletionProtection,
+			OnDestroy:          plan.OnDestroy,
 		}
 
 		if err != nil {
@@ -631,23 +686,7 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 			return
 		}
 	} else {
-		eventRuleResp, err := r.client.UpdateEventRule(uptycs.EventRule{
-			ID:          eventRuleID,
-			Name:        plan.Name.ValueString(),
-			Code:        plan.Code.ValueString(),
-			Description: plan.Description.ValueString(),
-			Rule:        plan.Rule.ValueString(),
-			Type:        plan.Type.ValueString(),
-			Enabled:     plan.Enabled.ValueBool(),
-			Grouping:    plan.Grouping.ValueString(),
-			GroupingL2:  plan.GroupingL2.ValueString(),
-			GroupingL3:  plan.GroupingL3.ValueString(),
-			EventTags:   tags,
-			Score:       plan.Score.ValueString(),
-			SQLConfig: &uptycs.SQLConfig{
-				IntervalSeconds: int(plan.SQLConfig.IntervalSeconds.ValueInt64()),
-			},
-		})
+		eventRuleResp, err := r.client.UpdateEventRule(eventRule)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -659,7 +698,7 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 		result = EventRule{
 			ID:          types.StringValue(eventRuleResp.ID),
 			Enabled:     types.BoolValue(eventRuleResp.Enabled),
-			Name:        types.StringValue(eventRuleResp.Name),
+			Name:        types.StringValue(strings.TrimPrefix(eventRuleResp.Name, r.settings.namePrefix)),
 			Description: types.StringValue(eventRuleResp.Description),
 			Code:        types.StringValue(eventRuleResp.Code),
 			Type:        types.StringValue(eventRuleResp.Type),
@@ -667,27 +706,30 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 			GroupingL2:  types.StringValue(eventRuleResp.GroupingL2),
 			GroupingL3:  types.StringValue(eventRuleResp.GroupingL3),
 			Score:       types.StringValue(eventRuleResp.Score),
-			EventTags:   makeListStringAttribute(eventRuleResp.EventTags),
+			EventTags:   makeListStringAttribute(removeDefaultTags(eventRuleResp.EventTags, r.settings.defaultEventTags, tags)),
 			Rule:        types.StringValue(eventRuleResp.Rule),
 			SQLConfig: &SQLConfig{
 				IntervalSeconds: types.Int64Value(int64(eventRuleResp.SQLConfig.IntervalSeconds)),
 			},
+			DeletionProtection: plan.DeletionProtection,
+			OnDestroy:          plan.OnDestroy,
 		}
 	}
 
 	// Do the attached alert rule
 	if eventRuleResp.Type == "builder" {
-		if !plan.BuilderConfig.AutoAlertConfig.RaiseAlert.ValueBool() {
-			if len(plan.AlertRule.Destinations) > 0 || len(plan.AlertRule.AlertRuleExceptions.Elements()) > 0 {
-				resp.Diagnostics.AddError(
-					"Error creating",
-					"alert_rule.destinations and alert_rule.rule_exceptions must be empty when builder_config.auto_alert_config.raise_alert is false",
-				)
-				return
-			}
-		} else {
+		alertRulePlan, err := builderAlertRule(plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating",
+				err.Error(),
+			)
+			return
+		}
+
+		if alertRulePlan != nil {
 			var ruleExceptions []string
-			if plan.AlertRule != nil && len(plan.AlertRule.AlertRuleExceptions.Elements()) > 0 {
+			if len(plan.AlertRule.AlertRuleExceptions.Elements()) > 0 {
 				plan.AlertRule.AlertRuleExceptions.ElementsAs(ctx, &ruleExceptions, true)
 			}
 			_ruleExceptions := make([]uptycs.RuleException, 0)
@@ -700,11 +742,24 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 				})
 			}
 			// Gather back the global rule exceptions, so we don't remove them at Update time by leaving
-			// them out of .AlertRuleExceptions[]
-			for _, _re := range eventRuleResp.Exceptions {
-				re, _ := r.client.GetException(uptycs.Exception{
-					ID: _re.ExceptionID,
-				})
+			// them out of .AlertRuleExceptions[]. They live on the attached alert rule, not the event rule
+			currentAlertRule, err := r.client.GetAlertRule(uptycs.AlertRule{ID: state.ID.ValueString()})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error updating",
+					"Could not get alertRule with ID  "+state.ID.ValueString()+": "+err.Error(),
+				)
+				return
+			}
+			for _, _re := range currentAlertRule.AlertRuleExceptions {
+				re, err := r.exceptions.get(ctx, _re.ExceptionID)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error updating",
+						"Could not get exception with ID  "+_re.ExceptionID+": "+err.Error(),
+					)
+					return
+				}
 				if re.IsGlobal {
 					_ruleExceptions = append(_ruleExceptions, uptycs.RuleException{
 						ExceptionID: re.ID,
@@ -713,15 +768,13 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 			}
 
 			_destinations := make([]uptycs.AlertRuleDestination, 0)
-			if plan.AlertRule != nil {
-				for _, d := range plan.AlertRule.Destinations {
-					_destinations = append(_destinations, uptycs.AlertRuleDestination{
-						Severity:           d.Severity.ValueString(),
-						DestinationID:      d.DestinationID.ValueString(),
-						NotifyEveryAlert:   d.NotifyEveryAlert.ValueBool(),
-						CloseAfterDelivery: d.CloseAfterDelivery.ValueBool(),
-					})
-				}
+			for _, d := range plan.AlertRule.Destinations {
+				_destinations = append(_destinations, uptycs.AlertRuleDestination{
+					Severity:           d.Severity.ValueString(),
+					DestinationID:      d.DestinationID.ValueString(),
+					NotifyEveryAlert:   d.NotifyEveryAlert.ValueBool(),
+					CloseAfterDelivery: d.CloseAfterDelivery.ValueBool(),
+				})
 			}
 
 			alertRule := uptycs.AlertRule{
@@ -762,9 +815,7 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 			}
 
 			arResult.Destinations = destinations
-			if plan.AlertRule != nil {
-				result.AlertRule = &arResult
-			}
+			result.AlertRule = &arResult
 		}
 
 	} else {
@@ -791,7 +842,63 @@ func (r *eventRuleResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
+// updatePayload builds the event rule the API expects on update from model,
+// which is the plan on update and the prior state when disabling on destroy.
+func (r *eventRuleResource) updatePayload(ctx context.Context, eventRuleID string, model EventRule) (uptycs.EventRule, error) {
+	eventRuleResp, err := r.client.GetEventRule(uptycs.EventRule{
+		ID: eventRuleID,
+	})
+	if err != nil {
+		return uptycs.EventRule{}, err
+	}
+
+	var tags []string
+	model.EventTags.ElementsAs(ctx, &tags, false)
+
+	eventRule := uptycs.EventRule{
+		ID:          eventRuleID,
+		Name:        r.settings.updateName(model.Name.ValueString(), eventRuleResp.Name),
+		Code:        model.Code.ValueString(),
+		Description: model.Description.ValueString(),
+		Rule:        model.Rule.ValueString(),
+		Type:        model.Type.ValueString(),
+		Enabled:     model.Enabled.ValueBool(),
+		Grouping:    model.Grouping.ValueString(),
+		GroupingL2:  model.GroupingL2.ValueString(),
+		GroupingL3:  model.GroupingL3.ValueString(),
+		EventTags:   addDefaultTags(tags, r.settings.defaultEventTags),
+		Score:       model.Score.ValueString(),
+	}
+
+	if model.Type.ValueString() == "builder" {
+		eventRule.BuilderConfig = &uptycs.BuilderConfig{
+			Filters:       uptycs.CustomJSONString(model.BuilderConfig.Filters.ValueString()),
+			TableName:     model.BuilderConfig.TableName.ValueString(),
+			Added:         model.BuilderConfig.Added.ValueBool(),
+			MatchesFilter: model.BuilderConfig.MatchesFilter.ValueBool(),
+			Severity:      model.BuilderConfig.Severity.ValueString(),
+			Key:           model.BuilderConfig.Key.ValueString(),
+			ValueField:    model.BuilderConfig.ValueField.ValueString(),
+			AutoAlertConfig: uptycs.AutoAlertConfig{
+				DisableAlert:    model.BuilderConfig.AutoAlertConfig.DisableAlert.ValueBool(),
+				RaiseAlert:      model.BuilderConfig.AutoAlertConfig.RaiseAlert.ValueBool(),
+				MetadataSources: uptycs.CustomJSONString(model.BuilderConfig.AutoAlertConfig.MetadataSources.ValueString()),
+			},
+		}
+	} else {
+		eventRule.SQLConfig = &uptycs.SQLConfig{
+			IntervalSeconds: int(model.SQLConfig.IntervalSeconds.ValueInt64()),
+		}
+	}
+
+	return eventRule, nil
+}
+
 func (r *eventRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state EventRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -799,8 +906,35 @@ func (r *eventRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting",
+			"Could not delete event rule "+state.Name.ValueString()+": deletion_protection is enabled. Set deletion_protection = false and apply before destroying it.",
+		)
+		return
+	}
+
 	eventRuleID := state.ID.ValueString()
 
+	if state.OnDestroy.ValueString() == "disable" {
+		eventRule, err := r.updatePayload(ctx, eventRuleID, state)
+		if err == nil {
+			eventRule.Enabled = false
+			_, err = r.client.UpdateEventRule(eventRule)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting",
+				"Could not disable event rule with ID  "+eventRuleID+": "+err.Error(),
+			)
+			return
+		}
+
+		// Leave the event rule in place, disabled, and only remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	_, err := r.client.DeleteEventRule(uptycs.EventRule{
 		ID: eventRuleID,
 	})
@@ -817,5 +951,48 @@ func (r *eventRuleResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *eventRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		return lookupPrefixedName(r.settings.namePrefix, name, func(name string) (string, error) {
+			eventRuleResp, err := r.client.GetEventRule(uptycs.EventRule{Name: name})
+			return eventRuleResp.ID, err
+		})
+	})
+}
+
+func (r *eventRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var ruleType, rule types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &ruleType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rule"), &rule)...)
+	if resp.Diagnostics.HasError() || rule.IsUnknown() || ruleType.ValueString() != "sql" {
+		return
+	}
+
+	changed := sqlChanged(ctx, req, path.Root("rule"), rule, &resp.Diagnostics)
+	validateSQL(ctx, r.tableSchemas, path.Root("rule"), rule.ValueString(), changed, &resp.Diagnostics)
+}
+
+func (r *eventRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateMITREGrouping(ctx, req.Config, &resp.Diagnostics)
+}
+
+// builderAlertRule returns the alert_rule settings to apply to the alert rule a
+// builder event rule raises. It is nil when the rule doesn't raise alerts, or
+// when alert_rule is unset and the attached alert rule is left to
+// uptycs_alert_rule_destination and uptycs_alert_rule_exception_attachment.
+func builderAlertRule(plan EventRule) (*AlertRuleLite, error) {
+	if plan.AlertRule == nil {
+		return nil, nil
+	}
+	if !plan.BuilderConfig.AutoAlertConfig.RaiseAlert.ValueBool() {
+		if len(plan.AlertRule.Destinations) > 0 || len(plan.AlertRule.AlertRuleExceptions.Elements()) > 0 {
+			return nil, errors.New("alert_rule.destinations and alert_rule.rule_exceptions must be empty when builder_config.auto_alert_config.raise_alert is false")
+		}
+		return nil, nil
+	}
+	return plan.AlertRule, nil
 }
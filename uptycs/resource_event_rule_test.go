@@ -0,0 +1,54 @@
+package uptycs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuilderAlertRule(t *testing.T) {
+	empty := &AlertRuleLite{
+		AlertRuleExceptions: types.ListValueMust(types.StringType, []attr.Value{}),
+		Destinations:        []AlertRuleDestination{},
+	}
+	withException := &AlertRuleLite{
+		AlertRuleExceptions: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("exception-id")}),
+		Destinations:        []AlertRuleDestination{},
+	}
+
+	tests := []struct {
+		name       string
+		raiseAlert bool
+		alertRule  *AlertRuleLite
+		want       *AlertRuleLite
+		wantErr    bool
+	}{
+		{name: "unset alert_rule is left to attachments", raiseAlert: true, alertRule: nil, want: nil},
+		{name: "unset alert_rule without alerts", raiseAlert: false, alertRule: nil, want: nil},
+		{name: "managed alert_rule", raiseAlert: true, alertRule: withException, want: withException},
+		{name: "empty alert_rule without alerts", raiseAlert: false, alertRule: empty, want: nil},
+		{name: "exceptions without alerts", raiseAlert: false, alertRule: withException, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := EventRule{
+				BuilderConfig: &BuilderConfig{
+					AutoAlertConfig: AutoAlertConfig{
+						RaiseAlert: types.BoolValue(tt.raiseAlert),
+					},
+				},
+				AlertRule: tt.alertRule,
+			}
+
+			got, err := builderAlertRule(plan)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
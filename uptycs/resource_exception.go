@@ -4,15 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
 	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"strings"
 )
 
 func ExceptionResource() resource.Resource {
@@ -20,7 +23,10 @@ func ExceptionResource() resource.Resource {
 }
 
 type exceptionResource struct {
-	client *uptycs.Client
+	client       *uptycs.Client
+	settings     providerSettings
+	exceptions   *exceptionCache
+	tableSchemas *tableSchemaCache
 }
 
 func (r *exceptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -32,7 +38,11 @@ func (r *exceptionResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+	r.exceptions = data.exceptions
+	r.tableSchemas = data.tableSchemas
 }
 
 func (r *exceptionResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -71,6 +81,15 @@ func (r *exceptionResource) Schema(_ context.Context, req resource.SchemaRequest
 				},
 			},
 			"rule": schema.StringAttribute{Optional: true},
+			"on_destroy": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("delete"),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"delete", "disable"}...),
+				},
+			},
 		},
 	}
 }
@@ -78,6 +97,11 @@ func (r *exceptionResource) Schema(_ context.Context, req resource.SchemaRequest
 func (r *exceptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var exceptionID string
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &exceptionID)...)
+	var onDestroy types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_destroy"), &onDestroy)...)
+	if onDestroy.IsNull() {
+		onDestroy = types.StringValue("delete")
+	}
 	exceptionResp, err := r.client.GetException(uptycs.Exception{
 		ID: exceptionID,
 	})
@@ -89,6 +113,8 @@ func (r *exceptionResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	r.exceptions.set(exceptionResp)
+
 	ruleJSON, err := json.MarshalIndent(exceptionResp.Rule, "", "  ")
 	if err != nil {
 		fmt.Println(err)
@@ -104,6 +130,7 @@ func (r *exceptionResource) Read(ctx context.Context, req resource.ReadRequest,
 		Disabled:        types.BoolValue(exceptionResp.Disabled),
 		CloseOpenAlerts: types.BoolValue(exceptionResp.CloseOpenAlerts),
 		Rule:            types.StringValue(string(ruleJSON) + "\n"),
+		OnDestroy:       onDestroy,
 	}
 
 	diags := resp.State.Set(ctx, result)
@@ -115,6 +142,10 @@ func (r *exceptionResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *exceptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan Exception
 	diags := req.Plan.Get(ctx, &plan)
@@ -123,6 +154,17 @@ func (r *exceptionResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetException(uptycs.Exception{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "exception "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	exceptionResp, err := r.client.CreateException(uptycs.Exception{
 		Name:            plan.Name.ValueString(),
 		Description:     plan.Description.ValueString(),
@@ -142,6 +184,8 @@ func (r *exceptionResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	r.exceptions.set(exceptionResp)
+
 	ruleJSON, err := json.MarshalIndent(exceptionResp.Rule, "", "  ")
 	if err != nil {
 		fmt.Println(err)
@@ -157,6 +201,7 @@ func (r *exceptionResource) Create(ctx context.Context, req resource.CreateReque
 		Disabled:        types.BoolValue(exceptionResp.Disabled),
 		CloseOpenAlerts: types.BoolValue(exceptionResp.CloseOpenAlerts),
 		Rule:            types.StringValue(string(ruleJSON) + "\n"),
+		OnDestroy:       plan.OnDestroy,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -167,6 +212,10 @@ func (r *exceptionResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *exceptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state Exception
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -184,17 +233,7 @@ func (r *exceptionResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	exceptionResp, err := r.client.UpdateException(uptycs.Exception{
-		ID:              exceptionID,
-		Name:            plan.Name.ValueString(),
-		Description:     plan.Description.ValueString(),
-		ExceptionType:   plan.ExceptionType.ValueString(),
-		TableName:       plan.TableName.ValueString(),
-		IsGlobal:        plan.IsGlobal.ValueBool(),
-		Disabled:        plan.Disabled.ValueBool(),
-		CloseOpenAlerts: plan.CloseOpenAlerts.ValueBool(),
-		Rule:            uptycs.CustomJSONString(plan.Rule.ValueString()),
-	})
+	exceptionResp, err := r.client.UpdateException(r.updatePayload(exceptionID, plan))
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -204,6 +243,8 @@ func (r *exceptionResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	r.exceptions.set(exceptionResp)
+
 	ruleJSON, err := json.MarshalIndent(exceptionResp.Rule, "", "  ")
 	if err != nil {
 		fmt.Println(err)
@@ -219,6 +260,7 @@ func (r *exceptionResource) Update(ctx context.Context, req resource.UpdateReque
 		Disabled:        types.BoolValue(exceptionResp.Disabled),
 		CloseOpenAlerts: types.BoolValue(exceptionResp.CloseOpenAlerts),
 		Rule:            types.StringValue(string(ruleJSON) + "\n"),
+		OnDestroy:       plan.OnDestroy,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -228,7 +270,27 @@ func (r *exceptionResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
+// updatePayload builds the exception the API expects on update from model,
+// which is the plan on update and the prior state when disabling on destroy.
+func (r *exceptionResource) updatePayload(exceptionID string, model Exception) uptycs.Exception {
+	return uptycs.Exception{
+		ID:              exceptionID,
+		Name:            model.Name.ValueString(),
+		Description:     model.Description.ValueString(),
+		ExceptionType:   model.ExceptionType.ValueString(),
+		TableName:       model.TableName.ValueString(),
+		IsGlobal:        model.IsGlobal.ValueBool(),
+		Disabled:        model.Disabled.ValueBool(),
+		CloseOpenAlerts: model.CloseOpenAlerts.ValueBool(),
+		Rule:            uptycs.CustomJSONString(model.Rule.ValueString()),
+	}
+}
+
 func (r *exceptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state Exception
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -238,6 +300,23 @@ func (r *exceptionResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	exceptionID := state.ID.ValueString()
 
+	if state.OnDestroy.ValueString() == "disable" {
+		exception := r.updatePayload(exceptionID, state)
+		exception.Disabled = true
+		_, err := r.client.UpdateException(exception)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting",
+				"Could not disable exception with ID  "+exceptionID+": "+err.Error(),
+			)
+			return
+		}
+
+		// Leave the exception in place, disabled, and only remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	_, err := r.client.DeleteException(uptycs.Exception{
 		ID: exceptionID,
 	})
@@ -249,9 +328,35 @@ func (r *exceptionResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	r.exceptions.remove(exceptionID)
+
 	resp.State.RemoveResource(ctx)
 }
 
 func (r *exceptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		exceptionResp, err := r.client.GetException(uptycs.Exception{Name: name})
+		return exceptionResp.ID, err
+	})
+}
+
+func (r *exceptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var rule types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rule"), &rule)...)
+	if resp.Diagnostics.HasError() || rule.IsUnknown() || rule.IsNull() {
+		return
+	}
+
+	// Builder exceptions are JSON filters rather than SQL
+	if trimmed := strings.TrimSpace(rule.ValueString()); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		return
+	}
+
+	changed := sqlChanged(ctx, req, path.Root("rule"), rule, &resp.Diagnostics)
+	validateSQL(ctx, r.tableSchemas, path.Root("rule"), rule.ValueString(), changed, &resp.Diagnostics)
 }
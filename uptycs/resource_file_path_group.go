@@ -17,7 +17,8 @@ func FilePathGroupResource() resource.Resource {
 }
 
 type filePathGroupResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *filePathGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -29,7 +30,9 @@ func (r *filePathGroupResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *filePathGroupResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -170,6 +173,10 @@ func (r *filePathGroupResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *filePathGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan FilePathGroup
 	diags := req.Plan.Get(ctx, &plan)
@@ -178,6 +185,17 @@ func (r *filePathGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetFilePathGroup(uptycs.FilePathGroup{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "filePathGroup "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var includePaths []string
 	plan.IncludePaths.ElementsAs(ctx, &includePaths, false)
 
@@ -276,6 +294,10 @@ func (r *filePathGroupResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *filePathGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state FilePathGroup
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -392,6 +414,10 @@ func (r *filePathGroupResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *filePathGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state FilePathGroup
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -417,5 +443,8 @@ func (r *filePathGroupResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *filePathGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		filePathGroupResp, err := r.client.GetFilePathGroup(uptycs.FilePathGroup{Name: name})
+		return filePathGroupResp.ID, err
+	})
 }
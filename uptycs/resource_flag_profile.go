@@ -16,7 +16,8 @@ func FlagProfileResource() resource.Resource {
 }
 
 type flagProfileResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *flagProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -28,7 +29,9 @@ func (r *flagProfileResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *flagProfileResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -87,6 +90,10 @@ func (r *flagProfileResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *flagProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan FlagProfile
 	diags := req.Plan.Get(ctx, &plan)
@@ -95,6 +102,17 @@ func (r *flagProfileResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetFlagProfile(uptycs.FlagProfile{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "flagProfile "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	flagProfileResp, err := r.client.CreateFlagProfile(uptycs.FlagProfile{
 		Name:         plan.Name.ValueString(),
 		Description:  plan.Description.ValueString(),
@@ -140,6 +158,10 @@ func (r *flagProfileResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *flagProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state FlagProfile
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -203,6 +225,10 @@ func (r *flagProfileResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *flagProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state FlagProfile
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -227,5 +253,8 @@ func (r *flagProfileResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *flagProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		flagProfileResp, err := r.client.GetFlagProfile(uptycs.FlagProfile{Name: name})
+		return flagProfileResp.ID, err
+	})
 }
@@ -0,0 +1,246 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func ImageLoadExclusionResource() resource.Resource {
+	return &imageLoadExclusionResource{}
+}
+
+type imageLoadExclusionResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *imageLoadExclusionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_load_exclusion"
+}
+
+func (r *imageLoadExclusionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *imageLoadExclusionResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultString(""),
+				},
+			},
+			"image_path": schema.StringAttribute{Required: true},
+			"process_path": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultString(""),
+				},
+			},
+			"enabled": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultBool(true),
+				},
+			},
+		},
+	}
+}
+
+func (r *imageLoadExclusionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var imageLoadExclusionID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &imageLoadExclusionID)...)
+	imageLoadExclusionResp, err := r.client.GetImageLoadExclusion(uptycs.ImageLoadExclusion{
+		ID: imageLoadExclusionID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get imageLoadExclusion with ID  "+imageLoadExclusionID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = ImageLoadExclusion{
+		ID:          types.StringValue(imageLoadExclusionResp.ID),
+		Name:        types.StringValue(imageLoadExclusionResp.Name),
+		Description: types.StringValue(imageLoadExclusionResp.Description),
+		ImagePath:   types.StringValue(imageLoadExclusionResp.ImagePath),
+		ProcessPath: types.StringValue(imageLoadExclusionResp.ProcessPath),
+		Enabled:     types.BoolValue(imageLoadExclusionResp.Enabled),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *imageLoadExclusionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ImageLoadExclusion
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetImageLoadExclusion(uptycs.ImageLoadExclusion{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "imageLoadExclusion "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	imageLoadExclusionResp, err := r.client.CreateImageLoadExclusion(uptycs.ImageLoadExclusion{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		ImagePath:   plan.ImagePath.ValueString(),
+		ProcessPath: plan.ProcessPath.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not create imageLoadExclusion, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = ImageLoadExclusion{
+		ID:          types.StringValue(imageLoadExclusionResp.ID),
+		Name:        types.StringValue(imageLoadExclusionResp.Name),
+		Description: types.StringValue(imageLoadExclusionResp.Description),
+		ImagePath:   types.StringValue(imageLoadExclusionResp.ImagePath),
+		ProcessPath: types.StringValue(imageLoadExclusionResp.ProcessPath),
+		Enabled:     types.BoolValue(imageLoadExclusionResp.Enabled),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *imageLoadExclusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
+	var state ImageLoadExclusion
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imageLoadExclusionID := state.ID.ValueString()
+
+	// Retrieve values from plan
+	var plan ImageLoadExclusion
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imageLoadExclusionResp, err := r.client.UpdateImageLoadExclusion(uptycs.ImageLoadExclusion{
+		ID:          imageLoadExclusionID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		ImagePath:   plan.ImagePath.ValueString(),
+		ProcessPath: plan.ProcessPath.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not update imageLoadExclusion, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = ImageLoadExclusion{
+		ID:          types.StringValue(imageLoadExclusionResp.ID),
+		Name:        types.StringValue(imageLoadExclusionResp.Name),
+		Description: types.StringValue(imageLoadExclusionResp.Description),
+		ImagePath:   types.StringValue(imageLoadExclusionResp.ImagePath),
+		ProcessPath: types.StringValue(imageLoadExclusionResp.ProcessPath),
+		Enabled:     types.BoolValue(imageLoadExclusionResp.Enabled),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *imageLoadExclusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state ImageLoadExclusion
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imageLoadExclusionID := state.ID.ValueString()
+
+	_, err := r.client.DeleteImageLoadExclusion(uptycs.ImageLoadExclusion{
+		ID: imageLoadExclusionID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not delete imageLoadExclusion with ID  "+imageLoadExclusionID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *imageLoadExclusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		imageLoadExclusionResp, err := r.client.GetImageLoadExclusion(uptycs.ImageLoadExclusion{Name: name})
+		return imageLoadExclusionResp.ID, err
+	})
+}
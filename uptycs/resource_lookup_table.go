@@ -12,6 +12,7 @@ import (
 	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
 	"github.com/uptycslabs/uptycs-client-go/uptycs"
 	"golang.org/x/exp/slices"
+	"strings"
 )
 
 func LookupTableResource() resource.Resource {
@@ -19,7 +20,8 @@ func LookupTableResource() resource.Resource {
 }
 
 type lookupTableResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *lookupTableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -31,7 +33,9 @@ func (r *lookupTableResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *lookupTableResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -85,6 +89,10 @@ func (r *lookupTableResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *lookupTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan LookupTable
 	diags := req.Plan.Get(ctx, &plan)
@@ -93,6 +101,17 @@ func (r *lookupTableResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetLookupTable(uptycs.LookupTable{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "lookupTable "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	lookupTableResp, err := r.client.CreateLookupTable(uptycs.LookupTable{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -144,6 +163,10 @@ func (r *lookupTableResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *lookupTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state LookupTable
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -267,6 +290,10 @@ func (r *lookupTableResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (r *lookupTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state LookupTable
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -291,5 +318,13 @@ func (r *lookupTableResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *lookupTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if uuidRegex.MatchString(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	lookupTableResp, err := r.client.GetLookupTable(uptycs.LookupTable{
+		Name: strings.TrimPrefix(req.ID, importNamePrefix),
+	})
+	importStateResolved(ctx, req.ID, resp, lookupTableResp.ID, err)
 }
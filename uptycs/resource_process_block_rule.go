@@ -0,0 +1,294 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func ProcessBlockRuleResource() resource.Resource {
+	return &processBlockRuleResource{}
+}
+
+type processBlockRuleResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *processBlockRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process_block_rule"
+}
+
+func (r *processBlockRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *processBlockRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultString(""),
+				},
+			},
+			"enabled": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultBool(true),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{Required: true},
+						"description": schema.StringAttribute{Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+								modifiers.DefaultString(""),
+							},
+						},
+						"rule": schema.StringAttribute{Required: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *processBlockRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var processBlockRuleID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &processBlockRuleID)...)
+	processBlockRuleResp, err := r.client.GetProcessBlockRule(uptycs.ProcessBlockRule{
+		ID: processBlockRuleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get processBlockRule with ID  "+processBlockRuleID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = ProcessBlockRule{
+		ID:          types.StringValue(processBlockRuleResp.ID),
+		Name:        types.StringValue(processBlockRuleResp.Name),
+		Description: types.StringValue(processBlockRuleResp.Description),
+		Enabled:     types.BoolValue(processBlockRuleResp.Enabled),
+	}
+
+	rules := make([]BlockRuleEntry, 0)
+	for _, _r := range processBlockRuleResp.Rules {
+		rules = append(rules, BlockRuleEntry{
+			Name:        types.StringValue(_r.Name),
+			Description: types.StringValue(_r.Description),
+			Rule:        types.StringValue(_r.Rule),
+		})
+	}
+	result.Rules = rules
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *processBlockRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan ProcessBlockRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetProcessBlockRule(uptycs.ProcessBlockRule{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "processBlockRule "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	_rules := make([]uptycs.ProcessBlockRuleEntry, 0)
+	for _, _r := range plan.Rules {
+		_rules = append(_rules, uptycs.ProcessBlockRuleEntry{
+			Name:        _r.Name.ValueString(),
+			Description: _r.Description.ValueString(),
+			Rule:        _r.Rule.ValueString(),
+		})
+	}
+
+	processBlockRuleResp, err := r.client.CreateProcessBlockRule(uptycs.ProcessBlockRule{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+		Rules:       _rules,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not create processBlockRule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = ProcessBlockRule{
+		ID:          types.StringValue(processBlockRuleResp.ID),
+		Name:        types.StringValue(processBlockRuleResp.Name),
+		Description: types.StringValue(processBlockRuleResp.Description),
+		Enabled:     types.BoolValue(processBlockRuleResp.Enabled),
+	}
+
+	rules := make([]BlockRuleEntry, 0)
+	for _, _r := range processBlockRuleResp.Rules {
+		rules = append(rules, BlockRuleEntry{
+			Name:        types.StringValue(_r.Name),
+			Description: types.StringValue(_r.Description),
+			Rule:        types.StringValue(_r.Rule),
+		})
+	}
+	result.Rules = rules
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *processBlockRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
+	var state ProcessBlockRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	processBlockRuleID := state.ID.ValueString()
+
+	// Retrieve values from plan
+	var plan ProcessBlockRule
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_rules := make([]uptycs.ProcessBlockRuleEntry, 0)
+	for _, _r := range plan.Rules {
+		_rules = append(_rules, uptycs.ProcessBlockRuleEntry{
+			Name:        _r.Name.ValueString(),
+			Description: _r.Description.ValueString(),
+			Rule:        _r.Rule.ValueString(),
+		})
+	}
+
+	processBlockRuleResp, err := r.client.UpdateProcessBlockRule(uptycs.ProcessBlockRule{
+		ID:          processBlockRuleID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+		Rules:       _rules,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not update processBlockRule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = ProcessBlockRule{
+		ID:          types.StringValue(processBlockRuleResp.ID),
+		Name:        types.StringValue(processBlockRuleResp.Name),
+		Description: types.StringValue(processBlockRuleResp.Description),
+		Enabled:     types.BoolValue(processBlockRuleResp.Enabled),
+	}
+
+	rules := make([]BlockRuleEntry, 0)
+	for _, _r := range processBlockRuleResp.Rules {
+		rules = append(rules, BlockRuleEntry{
+			Name:        types.StringValue(_r.Name),
+			Description: types.StringValue(_r.Description),
+			Rule:        types.StringValue(_r.Rule),
+		})
+	}
+	result.Rules = rules
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *processBlockRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state ProcessBlockRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	processBlockRuleID := state.ID.ValueString()
+
+	_, err := r.client.DeleteProcessBlockRule(uptycs.ProcessBlockRule{
+		ID: processBlockRuleID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not delete processBlockRule with ID  "+processBlockRuleID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *processBlockRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		processBlockRuleResp, err := r.client.GetProcessBlockRule(uptycs.ProcessBlockRule{Name: name})
+		return processBlockRuleResp.ID, err
+	})
+}
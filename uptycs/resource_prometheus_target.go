@@ -0,0 +1,263 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func PrometheusTargetResource() resource.Resource {
+	return &prometheusTargetResource{}
+}
+
+type prometheusTargetResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *prometheusTargetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prometheus_target"
+}
+
+func (r *prometheusTargetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *prometheusTargetResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultString(""),
+				},
+			},
+			"urls": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"scrape_interval": schema.Int64Attribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					modifiers.DefaultInt(60),
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *prometheusTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var prometheusTargetID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &prometheusTargetID)...)
+	prometheusTargetResp, err := r.client.GetPrometheusTarget(uptycs.PrometheusTarget{
+		ID: prometheusTargetID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get prometheusTarget with ID  "+prometheusTargetID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = PrometheusTarget{
+		ID:             types.StringValue(prometheusTargetResp.ID),
+		Name:           types.StringValue(prometheusTargetResp.Name),
+		Description:    types.StringValue(prometheusTargetResp.Description),
+		URLs:           makeListStringAttribute(prometheusTargetResp.URLs),
+		ScrapeInterval: types.Int64Value(int64(prometheusTargetResp.ScrapeInterval)),
+		Labels:         makeMapStringAttribute(prometheusTargetResp.Labels),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *prometheusTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan PrometheusTarget
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetPrometheusTarget(uptycs.PrometheusTarget{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "prometheusTarget "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var urls []string
+	plan.URLs.ElementsAs(ctx, &urls, false)
+
+	labels := make(map[string]string)
+	plan.Labels.ElementsAs(ctx, &labels, false)
+
+	prometheusTargetResp, err := r.client.CreatePrometheusTarget(uptycs.PrometheusTarget{
+		Name:           plan.Name.ValueString(),
+		Description:    plan.Description.ValueString(),
+		URLs:           urls,
+		ScrapeInterval: int(plan.ScrapeInterval.ValueInt64()),
+		Labels:         labels,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not create prometheusTarget, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = PrometheusTarget{
+		ID:             types.StringValue(prometheusTargetResp.ID),
+		Name:           types.StringValue(prometheusTargetResp.Name),
+		Description:    types.StringValue(prometheusTargetResp.Description),
+		URLs:           makeListStringAttribute(prometheusTargetResp.URLs),
+		ScrapeInterval: types.Int64Value(int64(prometheusTargetResp.ScrapeInterval)),
+		Labels:         makeMapStringAttribute(prometheusTargetResp.Labels),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *prometheusTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
+	var state PrometheusTarget
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prometheusTargetID := state.ID.ValueString()
+
+	// Retrieve values from plan
+	var plan PrometheusTarget
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var urls []string
+	plan.URLs.ElementsAs(ctx, &urls, false)
+
+	labels := make(map[string]string)
+	plan.Labels.ElementsAs(ctx, &labels, false)
+
+	prometheusTargetResp, err := r.client.UpdatePrometheusTarget(uptycs.PrometheusTarget{
+		ID:             prometheusTargetID,
+		Name:           plan.Name.ValueString(),
+		Description:    plan.Description.ValueString(),
+		URLs:           urls,
+		ScrapeInterval: int(plan.ScrapeInterval.ValueInt64()),
+		Labels:         labels,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not update prometheusTarget, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = PrometheusTarget{
+		ID:             types.StringValue(prometheusTargetResp.ID),
+		Name:           types.StringValue(prometheusTargetResp.Name),
+		Description:    types.StringValue(prometheusTargetResp.Description),
+		URLs:           makeListStringAttribute(prometheusTargetResp.URLs),
+		ScrapeInterval: types.Int64Value(int64(prometheusTargetResp.ScrapeInterval)),
+		Labels:         makeMapStringAttribute(prometheusTargetResp.Labels),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *prometheusTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state PrometheusTarget
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prometheusTargetID := state.ID.ValueString()
+
+	_, err := r.client.DeletePrometheusTarget(uptycs.PrometheusTarget{
+		ID: prometheusTargetID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not delete prometheusTarget with ID  "+prometheusTargetID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *prometheusTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		prometheusTargetResp, err := r.client.GetPrometheusTarget(uptycs.PrometheusTarget{Name: name})
+		return prometheusTargetResp.ID, err
+	})
+}
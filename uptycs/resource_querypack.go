@@ -20,7 +20,8 @@ func QuerypackResource() resource.Resource {
 }
 
 type querypackResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *querypackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -32,7 +33,9 @@ func (r *querypackResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *querypackResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -109,6 +112,10 @@ func (r *querypackResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *querypackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan Querypack
 	diags := req.Plan.Get(ctx, &plan)
@@ -117,6 +124,17 @@ func (r *querypackResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetQuerypack(uptycs.Querypack{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "querypack "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	querypackResp, err := r.client.CreateQuerypack(uptycs.Querypack{
 		Name:             plan.Name.ValueString(),
 		Description:      plan.Description.ValueString(),
@@ -160,6 +178,10 @@ func (r *querypackResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *querypackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state Querypack
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -169,6 +191,14 @@ func (r *querypackResource) Update(ctx context.Context, req resource.UpdateReque
 
 	queryPackID := state.ID.ValueString()
 
+	if state.IsInternal.ValueBool() && !r.settings.allowSystemObjectChanges {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Refusing to update internal querypack "+state.Name.ValueString()+": it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to update it anyway.",
+		)
+		return
+	}
+
 	// Retrieve values from plan
 	var plan Querypack
 	diags = req.Plan.Get(ctx, &plan)
@@ -201,6 +231,10 @@ func (r *querypackResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *querypackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state Querypack
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -210,6 +244,14 @@ func (r *querypackResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	queryPackID := state.ID.ValueString()
 
+	if state.IsInternal.ValueBool() && !r.settings.allowSystemObjectChanges {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Refusing to delete internal querypack "+state.Name.ValueString()+": it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to delete it anyway.",
+		)
+		return
+	}
+
 	_, err := r.client.DeleteQuerypack(uptycs.Querypack{
 		ID: queryPackID,
 	})
@@ -226,5 +268,8 @@ func (r *querypackResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *querypackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		querypackResp, err := r.client.GetQuerypack(uptycs.Querypack{Name: name})
+		return querypackResp.ID, err
+	})
 }
@@ -0,0 +1,294 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func RedactionResource() resource.Resource {
+	return &redactionResource{}
+}
+
+type redactionResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *redactionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_redaction"
+}
+
+func (r *redactionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *redactionResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultString(""),
+				},
+			},
+			"enabled": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultBool(true),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"table_name":  schema.StringAttribute{Required: true},
+						"column_name": schema.StringAttribute{Required: true},
+						"regex": schema.StringAttribute{Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+								modifiers.DefaultString(""),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *redactionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var redactionID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &redactionID)...)
+	redactionResp, err := r.client.GetRedaction(uptycs.Redaction{
+		ID: redactionID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get redaction with ID  "+redactionID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = Redaction{
+		ID:          types.StringValue(redactionResp.ID),
+		Name:        types.StringValue(redactionResp.Name),
+		Description: types.StringValue(redactionResp.Description),
+		Enabled:     types.BoolValue(redactionResp.Enabled),
+	}
+
+	rules := make([]RedactionEntry, 0)
+	for _, _r := range redactionResp.Rules {
+		rules = append(rules, RedactionEntry{
+			TableName:  types.StringValue(_r.TableName),
+			ColumnName: types.StringValue(_r.ColumnName),
+			Regex:      types.StringValue(_r.Regex),
+		})
+	}
+	result.Rules = rules
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *redactionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan Redaction
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetRedaction(uptycs.Redaction{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "redaction "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	_rules := make([]uptycs.RedactionRule, 0)
+	for _, _r := range plan.Rules {
+		_rules = append(_rules, uptycs.RedactionRule{
+			TableName:  _r.TableName.ValueString(),
+			ColumnName: _r.ColumnName.ValueString(),
+			Regex:      _r.Regex.ValueString(),
+		})
+	}
+
+	redactionResp, err := r.client.CreateRedaction(uptycs.Redaction{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+		Rules:       _rules,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not create redaction, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = Redaction{
+		ID:          types.StringValue(redactionResp.ID),
+		Name:        types.StringValue(redactionResp.Name),
+		Description: types.StringValue(redactionResp.Description),
+		Enabled:     types.BoolValue(redactionResp.Enabled),
+	}
+
+	rules := make([]RedactionEntry, 0)
+	for _, _r := range redactionResp.Rules {
+		rules = append(rules, RedactionEntry{
+			TableName:  types.StringValue(_r.TableName),
+			ColumnName: types.StringValue(_r.ColumnName),
+			Regex:      types.StringValue(_r.Regex),
+		})
+	}
+	result.Rules = rules
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *redactionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
+	var state Redaction
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redactionID := state.ID.ValueString()
+
+	// Retrieve values from plan
+	var plan Redaction
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_rules := make([]uptycs.RedactionRule, 0)
+	for _, _r := range plan.Rules {
+		_rules = append(_rules, uptycs.RedactionRule{
+			TableName:  _r.TableName.ValueString(),
+			ColumnName: _r.ColumnName.ValueString(),
+			Regex:      _r.Regex.ValueString(),
+		})
+	}
+
+	redactionResp, err := r.client.UpdateRedaction(uptycs.Redaction{
+		ID:          redactionID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Enabled:     plan.Enabled.ValueBool(),
+		Rules:       _rules,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not update redaction, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = Redaction{
+		ID:          types.StringValue(redactionResp.ID),
+		Name:        types.StringValue(redactionResp.Name),
+		Description: types.StringValue(redactionResp.Description),
+		Enabled:     types.BoolValue(redactionResp.Enabled),
+	}
+
+	rules := make([]RedactionEntry, 0)
+	for _, _r := range redactionResp.Rules {
+		rules = append(rules, RedactionEntry{
+			TableName:  types.StringValue(_r.TableName),
+			ColumnName: types.StringValue(_r.ColumnName),
+			Regex:      types.StringValue(_r.Regex),
+		})
+	}
+	result.Rules = rules
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *redactionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state Redaction
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	redactionID := state.ID.ValueString()
+
+	_, err := r.client.DeleteRedaction(uptycs.Redaction{
+		ID: redactionID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not delete redaction with ID  "+redactionID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *redactionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		redactionResp, err := r.client.GetRedaction(uptycs.Redaction{Name: name})
+		return redactionResp.ID, err
+	})
+}
@@ -18,7 +18,8 @@ func RegistryPathResource() resource.Resource {
 }
 
 type registryPathResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *registryPathResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -30,7 +31,9 @@ func (r *registryPathResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *registryPathResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -104,6 +107,10 @@ func (r *registryPathResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *registryPathResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan RegistryPath
 	diags := req.Plan.Get(ctx, &plan)
@@ -112,6 +119,17 @@ func (r *registryPathResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetRegistryPath(uptycs.RegistryPath{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "registryPath "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var includeRegistryPaths []string
 	plan.IncludeRegistryPaths.ElementsAs(ctx, &includeRegistryPaths, false)
 
@@ -153,6 +171,10 @@ func (r *registryPathResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *registryPathResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state RegistryPath
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -212,6 +234,10 @@ func (r *registryPathResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *registryPathResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state RegistryPath
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -237,5 +263,8 @@ func (r *registryPathResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *registryPathResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		registryPathResp, err := r.client.GetRegistryPath(uptycs.RegistryPath{Name: name})
+		return registryPathResp.ID, err
+	})
 }
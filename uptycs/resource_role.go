@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
 	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"golang.org/x/exp/slices"
 )
 
 func RoleResource() resource.Resource {
@@ -18,7 +19,9 @@ func RoleResource() resource.Resource {
 }
 
 type roleResource struct {
-	client *uptycs.Client
+	client      *uptycs.Client
+	settings    providerSettings
+	permissions *permissionCache
 }
 
 func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -30,7 +33,10 @@ func (r *roleResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+	r.permissions = data.permissions
 }
 
 func (r *roleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -61,6 +67,12 @@ func (r *roleResource) Schema(_ context.Context, req resource.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"deletion_protection": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
 		},
 	}
 }
@@ -68,6 +80,8 @@ func (r *roleResource) Schema(_ context.Context, req resource.SchemaRequest, res
 func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var roleID string
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &roleID)...)
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
 	roleResp, err := r.client.GetRole(uptycs.Role{
 		ID: roleID,
 	})
@@ -87,6 +101,7 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		Hidden:               types.BoolValue(roleResp.Hidden),
 		NoMinimalPermissions: types.BoolValue(roleResp.NoMinimalPermissions),
 		Permissions:          makeListStringAttribute(roleResp.Permissions),
+		DeletionProtection:   types.BoolValue(deletionProtection.ValueBool()),
 	}
 
 	diags := resp.State.Set(ctx, result)
@@ -98,6 +113,10 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan Role
 	diags := req.Plan.Get(ctx, &plan)
@@ -106,6 +125,17 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetRole(uptycs.Role{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "role "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Map the plan permissions list of strings into a straight []string for the upcoming create
 	var permissions []string
 	plan.Permissions.ElementsAs(ctx, &permissions, false)
@@ -143,6 +173,7 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Hidden:               types.BoolValue(roleResp.Hidden),
 		NoMinimalPermissions: types.BoolValue(roleResp.NoMinimalPermissions),
 		RoleObjectGroups:     makeListStringAttributeFn(roleResp.RoleObjectGroups, func(g uptycs.ObjectGroup) (string, bool) { return g.ObjectGroupID, true }),
+		DeletionProtection:   plan.DeletionProtection,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -153,6 +184,10 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state Role
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -206,6 +241,7 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Permissions: makeListStringAttribute(roleResp.Permissions), Hidden: types.BoolValue(roleResp.Hidden),
 		NoMinimalPermissions: types.BoolValue(roleResp.NoMinimalPermissions),
 		RoleObjectGroups:     makeListStringAttributeFn(roleResp.RoleObjectGroups, func(g uptycs.ObjectGroup) (string, bool) { return g.ObjectGroupID, true }),
+		DeletionProtection:   plan.DeletionProtection,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -216,6 +252,10 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state Role
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -223,6 +263,14 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting",
+			"Could not delete role "+state.Name.ValueString()+": deletion_protection is enabled. Set deletion_protection = false and apply before destroying it.",
+		)
+		return
+	}
+
 	roleID := state.ID.ValueString()
 
 	_, err := r.client.DeleteRole(uptycs.Role{
@@ -241,5 +289,48 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		roleResp, err := r.client.GetRole(uptycs.Role{Name: name})
+		return roleResp.ID, err
+	})
+}
+
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planPermissions types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("permissions"), &planPermissions)...)
+	if resp.Diagnostics.HasError() || planPermissions.IsUnknown() || planPermissions.IsNull() {
+		return
+	}
+
+	var permissions []types.String
+	resp.Diagnostics.Append(planPermissions.ElementsAs(ctx, &permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalogue, fromTenant := r.permissions.catalogue(ctx)
+	for i, p := range permissions {
+		if p.IsUnknown() || p.IsNull() || slices.Contains(catalogue, p.ValueString()) {
+			continue
+		}
+
+		if fromTenant {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("permissions").AtListIndex(i),
+				"Unknown permission",
+				"Permission "+p.ValueString()+" is not supported by this tenant. See the uptycs_permissions data source for the full list.",
+			)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("permissions").AtListIndex(i),
+				"Unknown permission",
+				"Permission "+p.ValueString()+" is not in the built-in permission catalogue. The tenant catalogue could not be fetched, so it will be checked at apply time.",
+			)
+		}
+	}
 }
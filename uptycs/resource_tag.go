@@ -2,15 +2,18 @@ package uptycs
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
 	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"strings"
 )
 
 func TagResource() resource.Resource {
@@ -18,7 +21,8 @@ func TagResource() resource.Resource {
 }
 
 type tagResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *tagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -30,7 +34,9 @@ func (r *tagResource) Configure(_ context.Context, req resource.ConfigureRequest
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *tagResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -142,6 +148,62 @@ func (r *tagResource) Schema(_ context.Context, req resource.SchemaRequest, resp
 				ElementType: types.StringType,
 				Required:    true,
 			},
+			"image_load_exclusions": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_groups": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destinations": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redactions": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audit_rules": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prometheus_targets": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"atc_queries": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -182,6 +244,13 @@ func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		RegistryPaths:             makeListStringAttributeFn(tagResp.RegistryPaths, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 		YaraGroupRules:            makeListStringAttributeFn(tagResp.YaraGroupRules, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 		AuditConfigurations:       makeListStringAttributeFn(tagResp.AuditConfigurations, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		ImageLoadExclusions:       makeListStringAttributeFn(tagResp.ImageLoadExclusions, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AuditGroups:               makeListStringAttributeFn(tagResp.AuditGroups, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		Destinations:              makeListStringAttributeFn(tagResp.Destinations, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		Redactions:                makeListStringAttributeFn(tagResp.Redactions, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AuditRules:                makeListStringAttributeFn(tagResp.AuditRules, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		PrometheusTargets:         makeListStringAttributeFn(tagResp.PrometheusTargets, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AtcQueries:                makeListStringAttributeFn(tagResp.AtcQueries, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 	}
 
 	diags := resp.State.Set(ctx, result)
@@ -193,6 +262,10 @@ func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 }
 
 func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan Tag
 	diags := req.Plan.Get(ctx, &plan)
@@ -201,6 +274,17 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetTag(uptycs.Tag{Key: plan.Key.ValueString(), Value: plan.Value.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "tag "+plan.Key.ValueString()+"="+plan.Value.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var filePathGroups = make([]uptycs.TagConfigurationObject, 0)
 	var filePathGroupIDs []string
 	plan.FilePathGroups.ElementsAs(ctx, &filePathGroupIDs, false)
@@ -255,6 +339,17 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		})
 	}
 
+	imageLoadExclusions := makeTagConfigurationObjects(ctx, plan.ImageLoadExclusions, &resp.Diagnostics)
+	auditGroups := makeTagConfigurationObjects(ctx, plan.AuditGroups, &resp.Diagnostics)
+	destinations := makeTagConfigurationObjects(ctx, plan.Destinations, &resp.Diagnostics)
+	redactions := makeTagConfigurationObjects(ctx, plan.Redactions, &resp.Diagnostics)
+	auditRules := makeTagConfigurationObjects(ctx, plan.AuditRules, &resp.Diagnostics)
+	prometheusTargets := makeTagConfigurationObjects(ctx, plan.PrometheusTargets, &resp.Diagnostics)
+	atcQueries := makeTagConfigurationObjects(ctx, plan.AtcQueries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tagResp, err := r.client.CreateTag(uptycs.Tag{
 		Value:                       plan.Value.ValueString(),
 		Key:                         plan.Key.ValueString(),
@@ -276,6 +371,13 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		Querypacks:                  queryPacks,
 		YaraGroupRules:              yaraGroupRules,
 		AuditConfigurations:         auditConfigurations,
+		ImageLoadExclusions:         imageLoadExclusions,
+		AuditGroups:                 auditGroups,
+		Destinations:                destinations,
+		Redactions:                  redactions,
+		AuditRules:                  auditRules,
+		PrometheusTargets:           prometheusTargets,
+		AtcQueries:                  atcQueries,
 	})
 
 	if err != nil {
@@ -308,6 +410,13 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		RegistryPaths:             makeListStringAttributeFn(tagResp.RegistryPaths, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 		YaraGroupRules:            makeListStringAttributeFn(tagResp.YaraGroupRules, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 		AuditConfigurations:       makeListStringAttributeFn(tagResp.AuditConfigurations, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		ImageLoadExclusions:       makeListStringAttributeFn(tagResp.ImageLoadExclusions, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AuditGroups:               makeListStringAttributeFn(tagResp.AuditGroups, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		Destinations:              makeListStringAttributeFn(tagResp.Destinations, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		Redactions:                makeListStringAttributeFn(tagResp.Redactions, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AuditRules:                makeListStringAttributeFn(tagResp.AuditRules, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		PrometheusTargets:         makeListStringAttributeFn(tagResp.PrometheusTargets, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AtcQueries:                makeListStringAttributeFn(tagResp.AtcQueries, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -318,6 +427,10 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 }
 
 func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state Tag
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -327,6 +440,14 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	tagID := state.ID.ValueString()
 
+	if state.System.ValueBool() && !r.settings.allowSystemObjectChanges {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Refusing to update system tag "+state.Key.ValueString()+"="+state.Value.ValueString()+": it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to update it anyway.",
+		)
+		return
+	}
+
 	// Retrieve values from plan
 	var plan Tag
 	diags = req.Plan.Get(ctx, &plan)
@@ -389,6 +510,19 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		})
 	}
 
+	// Attachments left out of the configuration are planned from the refreshed
+	// state, so applying a tag doesn't wipe out anything configured in the console
+	imageLoadExclusions := makeTagConfigurationObjects(ctx, plan.ImageLoadExclusions, &resp.Diagnostics)
+	auditGroups := makeTagConfigurationObjects(ctx, plan.AuditGroups, &resp.Diagnostics)
+	destinations := makeTagConfigurationObjects(ctx, plan.Destinations, &resp.Diagnostics)
+	redactions := makeTagConfigurationObjects(ctx, plan.Redactions, &resp.Diagnostics)
+	auditRules := makeTagConfigurationObjects(ctx, plan.AuditRules, &resp.Diagnostics)
+	prometheusTargets := makeTagConfigurationObjects(ctx, plan.PrometheusTargets, &resp.Diagnostics)
+	atcQueries := makeTagConfigurationObjects(ctx, plan.AtcQueries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tagResp, err := r.client.UpdateTag(uptycs.Tag{
 		ID:                          tagID,
 		Value:                       plan.Value.ValueString(),
@@ -408,6 +542,13 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		Querypacks:                  queryPacks,
 		YaraGroupRules:              yaraGroupRules,
 		AuditConfigurations:         auditConfigurations,
+		ImageLoadExclusions:         imageLoadExclusions,
+		AuditGroups:                 auditGroups,
+		Destinations:                destinations,
+		Redactions:                  redactions,
+		AuditRules:                  auditRules,
+		PrometheusTargets:           prometheusTargets,
+		AtcQueries:                  atcQueries,
 		//ResourceType:                plan.ResourceType.Value, //│ {"error":{"status":400,"code":"INVALID_OR_REQUIRED_FIELD","message":{"brief":"","detail":"\"resourceType\" is not allowed","developer":""}}}
 		//Status:                      plan.Status.Value,  // {"error":{"status":400,"code":"INVALID_OR_REQUIRED_FIELD","message":{"brief":"","detail":"\"status\" is│ not allowed","developer":""}}}
 		//Source:                      plan.Source.Value,  // {"error":{"status":400,"code":"INVALID_OR_REQUIRED_FIELD","message":{"brief":"","detail":"\"source\" is│ not allowed","developer":""}}}
@@ -443,6 +584,13 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		RegistryPaths:             makeListStringAttributeFn(tagResp.RegistryPaths, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 		YaraGroupRules:            makeListStringAttributeFn(tagResp.YaraGroupRules, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 		AuditConfigurations:       makeListStringAttributeFn(tagResp.AuditConfigurations, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		ImageLoadExclusions:       makeListStringAttributeFn(tagResp.ImageLoadExclusions, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AuditGroups:               makeListStringAttributeFn(tagResp.AuditGroups, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		Destinations:              makeListStringAttributeFn(tagResp.Destinations, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		Redactions:                makeListStringAttributeFn(tagResp.Redactions, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AuditRules:                makeListStringAttributeFn(tagResp.AuditRules, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		PrometheusTargets:         makeListStringAttributeFn(tagResp.PrometheusTargets, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
+		AtcQueries:                makeListStringAttributeFn(tagResp.AtcQueries, func(f uptycs.TagConfigurationObject) (string, bool) { return f.ID, true }),
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -453,6 +601,10 @@ func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 }
 
 func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state Tag
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -462,6 +614,14 @@ func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 
 	tagID := state.ID.ValueString()
 
+	if state.System.ValueBool() && !r.settings.allowSystemObjectChanges {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Refusing to delete system tag "+state.Key.ValueString()+"="+state.Value.ValueString()+": it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to delete it anyway.",
+		)
+		return
+	}
+
 	_, err := r.client.DeleteTag(uptycs.Tag{
 		ID: tagID,
 	})
@@ -478,5 +638,28 @@ func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *tagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	key, value, found := strings.Cut(req.ID, "=")
+	if !found {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	tagResp, err := r.client.GetTag(uptycs.Tag{Key: key, Value: value})
+	importStateResolved(ctx, req.ID, resp, tagResp.ID, err)
+}
+
+// makeTagConfigurationObjects maps a list of IDs from the plan into the objects the API expects.
+// The list is only unknown on create, when there is nothing to attach yet.
+func makeTagConfigurationObjects(ctx context.Context, ids types.List, diags *diag.Diagnostics) []uptycs.TagConfigurationObject {
+	var _ids []string
+	if !ids.IsNull() && !ids.IsUnknown() {
+		diags.Append(ids.ElementsAs(ctx, &_ids, false)...)
+	}
+	objects := make([]uptycs.TagConfigurationObject, 0)
+	for _, id := range _ids {
+		objects = append(objects, uptycs.TagConfigurationObject{
+			ID: id,
+		})
+	}
+	return objects
 }
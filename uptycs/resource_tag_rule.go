@@ -2,13 +2,16 @@ package uptycs
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
 	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"strings"
 )
 
 func TagRuleResource() resource.Resource {
@@ -16,7 +19,9 @@ func TagRuleResource() resource.Resource {
 }
 
 type tagRuleResource struct {
-	client *uptycs.Client
+	client       *uptycs.Client
+	settings     providerSettings
+	tableSchemas *tableSchemaCache
 }
 
 func (r *tagRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -28,7 +33,10 @@ func (r *tagRuleResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+	r.tableSchemas = data.tableSchemas
 }
 
 func (r *tagRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -78,6 +86,15 @@ func (r *tagRuleResource) Schema(_ context.Context, req resource.SchemaRequest,
 					modifiers.DefaultBool(true),
 				},
 			},
+			"on_destroy": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.DefaultString("delete"),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{"delete", "disable"}...),
+				},
+			},
 		},
 	}
 }
@@ -85,6 +102,11 @@ func (r *tagRuleResource) Schema(_ context.Context, req resource.SchemaRequest,
 func (r *tagRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var tagRuleID string
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &tagRuleID)...)
+	var onDestroy types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("on_destroy"), &onDestroy)...)
+	if onDestroy.IsNull() {
+		onDestroy = types.StringValue("delete")
+	}
 	tagRuleResp, err := r.client.GetTagRule(uptycs.TagRule{
 		ID: tagRuleID,
 	})
@@ -98,7 +120,7 @@ func (r *tagRuleResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	var result = TagRule{
 		ID:             types.StringValue(tagRuleResp.ID),
-		Name:           types.StringValue(tagRuleResp.Name),
+		Name:           types.StringValue(strings.TrimPrefix(tagRuleResp.Name, r.settings.namePrefix)),
 		Description:    types.StringValue(tagRuleResp.Description),
 		Query:          types.StringValue(tagRuleResp.Query),
 		Source:         types.StringValue(tagRuleResp.Source),
@@ -109,6 +131,7 @@ func (r *tagRuleResource) Read(ctx context.Context, req resource.ReadRequest, re
 		Enabled:        types.BoolValue(tagRuleResp.Enabled),
 		System:         types.BoolValue(tagRuleResp.System),
 		ResourceType:   types.StringValue(tagRuleResp.ResourceType),
+		OnDestroy:      onDestroy,
 	}
 
 	diags := resp.State.Set(ctx, result)
@@ -120,6 +143,10 @@ func (r *tagRuleResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *tagRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan TagRule
 	diags := req.Plan.Get(ctx, &plan)
@@ -127,9 +154,20 @@ func (r *tagRuleResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetTagRule(uptycs.TagRule{Name: r.settings.namePrefix + plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "tagRule "+r.settings.namePrefix+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	tagRuleResp, err := r.client.CreateTagRule(uptycs.TagRule{
 		ID:             plan.ID.ValueString(),
-		Name:           plan.Name.ValueString(),
+		Name:           r.settings.namePrefix + plan.Name.ValueString(),
 		Description:    plan.Description.ValueString(),
 		Query:          plan.Query.ValueString(),
 		Source:         plan.Source.ValueString(),
@@ -152,7 +190,7 @@ func (r *tagRuleResource) Create(ctx context.Context, req resource.CreateRequest
 
 	var result = TagRule{
 		ID:             types.StringValue(tagRuleResp.ID),
-		Name:           types.StringValue(tagRuleResp.Name),
+		Name:           types.StringValue(strings.TrimPrefix(tagRuleResp.Name, r.settings.namePrefix)),
 		Description:    types.StringValue(tagRuleResp.Description),
 		Query:          types.StringValue(tagRuleResp.Query),
 		Source:         types.StringValue(tagRuleResp.Source),
@@ -163,6 +201,7 @@ func (r *tagRuleResource) Create(ctx context.Context, req resource.CreateRequest
 		Enabled:        types.BoolValue(tagRuleResp.Enabled),
 		System:         types.BoolValue(tagRuleResp.System),
 		ResourceType:   types.StringValue(tagRuleResp.ResourceType),
+		OnDestroy:      plan.OnDestroy,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -173,6 +212,10 @@ func (r *tagRuleResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *tagRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state TagRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -182,6 +225,14 @@ func (r *tagRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	tagRuleID := state.ID.ValueString()
 
+	if state.System.ValueBool() && !r.settings.allowSystemObjectChanges {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Refusing to update system tag rule "+state.Name.ValueString()+": it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to update it anyway.",
+		)
+		return
+	}
+
 	// Retrieve values from plan
 	var plan TagRule
 	diags = req.Plan.Get(ctx, &plan)
@@ -190,20 +241,16 @@ func (r *tagRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	tagRuleResp, err := r.client.UpdateTagRule(uptycs.TagRule{
-		ID:             tagRuleID,
-		Name:           plan.Name.ValueString(),
-		Description:    plan.Description.ValueString(),
-		Query:          plan.Query.ValueString(),
-		Source:         plan.Source.ValueString(),
-		RunOnce:        plan.RunOnce.ValueBool(),
-		Interval:       int(plan.Interval.ValueInt64()),
-		OSqueryVersion: plan.OSqueryVersion.ValueString(),
-		Platform:       plan.Platform.ValueString(),
-		Enabled:        plan.Enabled.ValueBool(),
-		ResourceType:   plan.ResourceType.ValueString(),
-		// System:         plan.System.Value, //"error":{"status":400,"code":"INVALID_OR_REQUIRED_FIELD","message":{"brief":"","detail":"\"system\"│ is not allowed","developer":""}}}
-	})
+	tagRule, err := r.updatePayload(tagRuleID, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not get tagRule with ID  "+tagRuleID+": "+err.Error(),
+		)
+		return
+	}
+
+	tagRuleResp, err := r.client.UpdateTagRule(tagRule)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -215,7 +262,7 @@ func (r *tagRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	var result = TagRule{
 		ID:             types.StringValue(tagRuleResp.ID),
-		Name:           types.StringValue(tagRuleResp.Name),
+		Name:           types.StringValue(strings.TrimPrefix(tagRuleResp.Name, r.settings.namePrefix)),
 		Description:    types.StringValue(tagRuleResp.Description),
 		Query:          types.StringValue(tagRuleResp.Query),
 		Source:         types.StringValue(tagRuleResp.Source),
@@ -226,6 +273,7 @@ func (r *tagRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 		Enabled:        types.BoolValue(tagRuleResp.Enabled),
 		System:         types.BoolValue(tagRuleResp.System),
 		ResourceType:   types.StringValue(tagRuleResp.ResourceType),
+		OnDestroy:      plan.OnDestroy,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -235,7 +283,37 @@ func (r *tagRuleResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 }
 
+// updatePayload builds the tag rule the API expects on update from model,
+// which is the plan on update and the prior state when disabling on destroy.
+func (r *tagRuleResource) updatePayload(tagRuleID string, model TagRule) (uptycs.TagRule, error) {
+	tagRuleResp, err := r.client.GetTagRule(uptycs.TagRule{
+		ID: tagRuleID,
+	})
+	if err != nil {
+		return uptycs.TagRule{}, err
+	}
+
+	return uptycs.TagRule{
+		ID:             tagRuleID,
+		Name:           r.settings.updateName(model.Name.ValueString(), tagRuleResp.Name),
+		Description:    model.Description.ValueString(),
+		Query:          model.Query.ValueString(),
+		Source:         model.Source.ValueString(),
+		RunOnce:        model.RunOnce.ValueBool(),
+		Interval:       int(model.Interval.ValueInt64()),
+		OSqueryVersion: model.OSqueryVersion.ValueString(),
+		Platform:       model.Platform.ValueString(),
+		Enabled:        model.Enabled.ValueBool(),
+		ResourceType:   model.ResourceType.ValueString(),
+		// System:         model.System.Value, //"error":{"status":400,"code":"INVALID_OR_REQUIRED_FIELD","message":{"brief":"","detail":"\"system\"│ is not allowed","developer":""}}}
+	}, nil
+}
+
 func (r *tagRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state TagRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -245,6 +323,33 @@ func (r *tagRuleResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	tagRuleID := state.ID.ValueString()
 
+	if state.System.ValueBool() && !r.settings.allowSystemObjectChanges {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Refusing to delete system tag rule "+state.Name.ValueString()+": it is shipped by Uptycs. Set allow_system_object_changes = true on the provider to delete it anyway.",
+		)
+		return
+	}
+
+	if state.OnDestroy.ValueString() == "disable" {
+		tagRule, err := r.updatePayload(tagRuleID, state)
+		if err == nil {
+			tagRule.Enabled = false
+			_, err = r.client.UpdateTagRule(tagRule)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting",
+				"Could not disable tag rule with ID  "+tagRuleID+": "+err.Error(),
+			)
+			return
+		}
+
+		// Leave the tag rule in place, disabled, and only remove it from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	_, err := r.client.DeleteTagRule(uptycs.TagRule{
 		ID: tagRuleID,
 	})
@@ -261,5 +366,33 @@ func (r *tagRuleResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *tagRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		return lookupPrefixedName(r.settings.namePrefix, name, func(name string) (string, error) {
+			tagRuleResp, err := r.client.GetTagRule(uptycs.TagRule{Name: name})
+			return tagRuleResp.ID, err
+		})
+	})
+}
+
+func (r *tagRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var source, query types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source"), &source)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("query"), &query)...)
+	if resp.Diagnostics.HasError() || query.IsUnknown() {
+		return
+	}
+
+	// Realtime tag rules run on the endpoints' osquery, whose tables aren't
+	// in the global catalogue
+	if source.ValueString() != "global" {
+		return
+	}
+
+	changed := sqlChanged(ctx, req, path.Root("query"), query, &resp.Diagnostics)
+	validateSQL(ctx, r.tableSchemas, path.Root("query"), query.ValueString(), changed, &resp.Diagnostics)
 }
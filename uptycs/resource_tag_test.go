@@ -0,0 +1,58 @@
+package uptycs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Unset tag configuration lists must be planned from the refreshed state, so
+// that applying a tag sends back what is configured in the console.
+func TestTagConfigurationListsKeepStateWhenUnset(t *testing.T) {
+	ctx := context.Background()
+	resp := &resource.SchemaResponse{}
+	(&tagResource{}).Schema(ctx, resource.SchemaRequest{}, resp)
+
+	current := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("console-id")})
+	for _, name := range []string{"image_load_exclusions", "audit_groups", "destinations", "redactions", "audit_rules", "prometheus_targets", "atc_queries"} {
+		t.Run(name, func(t *testing.T) {
+			a, ok := resp.Schema.Attributes[name].(schema.ListAttribute)
+			if !ok || !a.Optional || !a.Computed {
+				t.Fatalf("%s should be an optional, computed list", name)
+			}
+
+			req := planmodifier.ListRequest{
+				ConfigValue: types.ListNull(types.StringType),
+				PlanValue:   types.ListUnknown(types.StringType),
+				StateValue:  current,
+			}
+			modifyResp := &planmodifier.ListResponse{PlanValue: req.PlanValue}
+			for _, m := range a.PlanModifiers {
+				m.PlanModifyList(ctx, req, modifyResp)
+			}
+			if !modifyResp.PlanValue.Equal(current) {
+				t.Errorf("planned %s, want the state value %s", modifyResp.PlanValue, current)
+			}
+
+			var diags diag.Diagnostics
+			objects := makeTagConfigurationObjects(ctx, modifyResp.PlanValue, &diags)
+			if diags.HasError() || len(objects) != 1 || objects[0].ID != "console-id" {
+				t.Errorf("sent %v (%v), want the console attachment", objects, diags)
+			}
+		})
+	}
+}
+
+func TestMakeTagConfigurationObjectsUnknown(t *testing.T) {
+	var diags diag.Diagnostics
+	objects := makeTagConfigurationObjects(context.Background(), types.ListUnknown(types.StringType), &diags)
+	if diags.HasError() || objects == nil || len(objects) != 0 {
+		t.Errorf("got %v (%v), want an empty list", objects, diags)
+	}
+}
@@ -20,7 +20,8 @@ func UserResource() resource.Resource {
 }
 
 type userResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -32,7 +33,9 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *userResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -104,12 +107,20 @@ func (r *userResource) Schema(_ context.Context, req resource.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"deletion_protection": schema.BoolAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.DefaultBool(false),
+				},
+			},
 		},
 	}
 }
 func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var userID string
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &userID)...)
+	var deletionProtection types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
 
 	userResp, err := r.client.GetUser(uptycs.User{
 		ID: userID,
@@ -135,6 +146,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		Roles:              makeListStringAttributeFn(userResp.Roles, func(f uptycs.Role) (string, bool) { return f.ID, true }),
 		AlertHiddenColumns: makeListStringAttribute(userResp.AlertHiddenColumns),
 		UserObjectGroups:   makeListStringAttributeFn(userResp.UserObjectGroups, func(g uptycs.ObjectGroup) (string, bool) { return g.ObjectGroupID, true }),
+		DeletionProtection: types.BoolValue(deletionProtection.ValueBool()),
 	}
 
 	diags := resp.State.Set(ctx, result)
@@ -146,6 +158,10 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan User
 	diags := req.Plan.Get(ctx, &plan)
@@ -154,6 +170,17 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetUser(uptycs.User{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "user "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	var alertHiddenColumns []string
 	plan.AlertHiddenColumns.ElementsAs(ctx, &alertHiddenColumns, false)
 
@@ -212,6 +239,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Roles:              makeListStringAttributeFn(userResp.Roles, func(f uptycs.Role) (string, bool) { return f.ID, true }),
 		AlertHiddenColumns: makeListStringAttribute(userResp.AlertHiddenColumns),
 		UserObjectGroups:   makeListStringAttributeFn(userResp.UserObjectGroups, func(g uptycs.ObjectGroup) (string, bool) { return g.ObjectGroupID, true }),
+		DeletionProtection: plan.DeletionProtection,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -222,6 +250,10 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state User
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -298,6 +330,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Roles:              makeListStringAttributeFn(userResp.Roles, func(f uptycs.Role) (string, bool) { return f.ID, true }),
 		AlertHiddenColumns: makeListStringAttribute(userResp.AlertHiddenColumns),
 		UserObjectGroups:   makeListStringAttributeFn(userResp.UserObjectGroups, func(g uptycs.ObjectGroup) (string, bool) { return g.ObjectGroupID, true }),
+		DeletionProtection: plan.DeletionProtection,
 	}
 
 	diags = resp.State.Set(ctx, result)
@@ -308,6 +341,10 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state User
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -315,6 +352,14 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Deleting",
+			"Could not delete user "+state.Name.ValueString()+": deletion_protection is enabled. Set deletion_protection = false and apply before destroying it.",
+		)
+		return
+	}
+
 	userID := state.ID.ValueString()
 
 	_, err := r.client.DeleteUser(uptycs.User{
@@ -333,5 +378,8 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		userResp, err := r.client.GetUser(uptycs.User{Name: name})
+		return userResp.ID, err
+	})
 }
@@ -0,0 +1,436 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/myoung34/terraform-plugin-framework-utils/modifiers"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func WindowsDefenderPreferenceResource() resource.Resource {
+	return &windowsDefenderPreferenceResource{}
+}
+
+type windowsDefenderPreferenceResource struct {
+	client   *uptycs.Client
+	settings providerSettings
+}
+
+func (r *windowsDefenderPreferenceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_windows_defender_preference"
+}
+
+func (r *windowsDefenderPreferenceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
+}
+
+func (r *windowsDefenderPreferenceResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					modifiers.DefaultString(""),
+				},
+			},
+			"preferences": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"disable_realtime_monitoring": schema.BoolAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultBool(false),
+						},
+					},
+					"disable_behavior_monitoring": schema.BoolAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultBool(false),
+						},
+					},
+					"disable_ioav_protection": schema.BoolAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultBool(false),
+						},
+					},
+					"disable_script_scanning": schema.BoolAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultBool(false),
+						},
+					},
+					"disable_archive_scanning": schema.BoolAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultBool(false),
+						},
+					},
+					"disable_removable_drive_scanning": schema.BoolAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultBool(false),
+						},
+					},
+					"disable_email_scanning": schema.BoolAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultBool(false),
+						},
+					},
+					"pua_protection": schema.StringAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultString("disabled"),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"disabled", "enabled", "audit"}...),
+						},
+					},
+					"cloud_block_level": schema.StringAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultString("default"),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"default", "moderate", "high", "high_plus", "zero_tolerance"}...),
+						},
+					},
+					"cloud_extended_timeout": schema.Int64Attribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							modifiers.DefaultInt(0),
+						},
+					},
+					"maps_reporting": schema.StringAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultString("advanced"),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"disabled", "basic", "advanced"}...),
+						},
+					},
+					"submit_samples_consent": schema.StringAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultString("send_safe_samples"),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"always_prompt", "send_safe_samples", "never_send", "send_all_samples"}...),
+						},
+					},
+					"enable_network_protection": schema.StringAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultString("disabled"),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"disabled", "enabled", "audit"}...),
+						},
+					},
+					"enable_controlled_folder_access": schema.StringAttribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							modifiers.DefaultString("disabled"),
+						},
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{"disabled", "enabled", "audit"}...),
+						},
+					},
+					"signature_update_interval": schema.Int64Attribute{Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							modifiers.DefaultInt(0),
+						},
+					},
+					"exclusion_paths": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"exclusion_extensions": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"exclusion_processes": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *windowsDefenderPreferenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var windowsDefenderPreferenceID string
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &windowsDefenderPreferenceID)...)
+	windowsDefenderPreferenceResp, err := r.client.GetWindowsDefenderPreference(uptycs.WindowsDefenderPreference{
+		ID: windowsDefenderPreferenceID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading",
+			"Could not get windowsDefenderPreference with ID  "+windowsDefenderPreferenceID+": "+err.Error(),
+		)
+		return
+	}
+
+	var result = WindowsDefenderPreference{
+		ID:          types.StringValue(windowsDefenderPreferenceResp.ID),
+		Name:        types.StringValue(windowsDefenderPreferenceResp.Name),
+		Description: types.StringValue(windowsDefenderPreferenceResp.Description),
+		Preferences: makeWindowsDefenderPreferences(windowsDefenderPreferenceResp.Preferences),
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (r *windowsDefenderPreferenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan WindowsDefenderPreference
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetWindowsDefenderPreference(uptycs.WindowsDefenderPreference{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "windowsDefenderPreference "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	windowsDefenderPreferenceResp, err := r.client.CreateWindowsDefenderPreference(uptycs.WindowsDefenderPreference{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Preferences: makeUptycsWindowsDefenderPreferences(ctx, plan.Preferences),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating",
+			"Could not create windowsDefenderPreference, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = WindowsDefenderPreference{
+		ID:          types.StringValue(windowsDefenderPreferenceResp.ID),
+		Name:        types.StringValue(windowsDefenderPreferenceResp.Name),
+		Description: types.StringValue(windowsDefenderPreferenceResp.Description),
+		Preferences: makeWindowsDefenderPreferences(windowsDefenderPreferenceResp.Preferences),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *windowsDefenderPreferenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
+	var state WindowsDefenderPreference
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowsDefenderPreferenceID := state.ID.ValueString()
+
+	// Retrieve values from plan
+	var plan WindowsDefenderPreference
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowsDefenderPreferenceResp, err := r.client.UpdateWindowsDefenderPreference(uptycs.WindowsDefenderPreference{
+		ID:          windowsDefenderPreferenceID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Preferences: makeUptycsWindowsDefenderPreferences(ctx, plan.Preferences),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating",
+			"Could not update windowsDefenderPreference, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var result = WindowsDefenderPreference{
+		ID:          types.StringValue(windowsDefenderPreferenceResp.ID),
+		Name:        types.StringValue(windowsDefenderPreferenceResp.Name),
+		Description: types.StringValue(windowsDefenderPreferenceResp.Description),
+		Preferences: makeWindowsDefenderPreferences(windowsDefenderPreferenceResp.Preferences),
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *windowsDefenderPreferenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
+	var state WindowsDefenderPreference
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowsDefenderPreferenceID := state.ID.ValueString()
+
+	_, err := r.client.DeleteWindowsDefenderPreference(uptycs.WindowsDefenderPreference{
+		ID: windowsDefenderPreferenceID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting",
+			"Could not delete windowsDefenderPreference with ID  "+windowsDefenderPreferenceID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Remove resource from state
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *windowsDefenderPreferenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		windowsDefenderPreferenceResp, err := r.client.GetWindowsDefenderPreference(uptycs.WindowsDefenderPreference{Name: name})
+		return windowsDefenderPreferenceResp.ID, err
+	})
+}
+
+func makeWindowsDefenderPreferences(in uptycs.WindowsDefenderPreferences) *WindowsDefenderPreferences {
+	return &WindowsDefenderPreferences{
+		DisableRealtimeMonitoring:     types.BoolValue(in.DisableRealtimeMonitoring),
+		DisableBehaviorMonitoring:     types.BoolValue(in.DisableBehaviorMonitoring),
+		DisableIOAVProtection:         types.BoolValue(in.DisableIOAVProtection),
+		DisableScriptScanning:         types.BoolValue(in.DisableScriptScanning),
+		DisableArchiveScanning:        types.BoolValue(in.DisableArchiveScanning),
+		DisableRemovableDriveScanning: types.BoolValue(in.DisableRemovableDriveScanning),
+		DisableEmailScanning:          types.BoolValue(in.DisableEmailScanning),
+		PUAProtection:                 types.StringValue(in.PUAProtection),
+		CloudBlockLevel:               types.StringValue(in.CloudBlockLevel),
+		CloudExtendedTimeout:          types.Int64Value(int64(in.CloudExtendedTimeout)),
+		MAPSReporting:                 types.StringValue(in.MAPSReporting),
+		SubmitSamplesConsent:          types.StringValue(in.SubmitSamplesConsent),
+		EnableNetworkProtection:       types.StringValue(in.EnableNetworkProtection),
+		EnableControlledFolderAccess:  types.StringValue(in.EnableControlledFolderAccess),
+		SignatureUpdateInterval:       types.Int64Value(int64(in.SignatureUpdateInterval)),
+		ExclusionPaths:                makeListStringAttribute(in.ExclusionPaths),
+		ExclusionExtensions:           makeListStringAttribute(in.ExclusionExtensions),
+		ExclusionProcesses:            makeListStringAttribute(in.ExclusionProcesses),
+	}
+}
+
+func makeUptycsWindowsDefenderPreferences(ctx context.Context, in *WindowsDefenderPreferences) uptycs.WindowsDefenderPreferences {
+	if in == nil {
+		return uptycs.WindowsDefenderPreferences{}
+	}
+
+	var exclusionPaths []string
+	in.ExclusionPaths.ElementsAs(ctx, &exclusionPaths, false)
+
+	var exclusionExtensions []string
+	in.ExclusionExtensions.ElementsAs(ctx, &exclusionExtensions, false)
+
+	var exclusionProcesses []string
+	in.ExclusionProcesses.ElementsAs(ctx, &exclusionProcesses, false)
+
+	return uptycs.WindowsDefenderPreferences{
+		DisableRealtimeMonitoring:     in.DisableRealtimeMonitoring.ValueBool(),
+		DisableBehaviorMonitoring:     in.DisableBehaviorMonitoring.ValueBool(),
+		DisableIOAVProtection:         in.DisableIOAVProtection.ValueBool(),
+		DisableScriptScanning:         in.DisableScriptScanning.ValueBool(),
+		DisableArchiveScanning:        in.DisableArchiveScanning.ValueBool(),
+		DisableRemovableDriveScanning: in.DisableRemovableDriveScanning.ValueBool(),
+		DisableEmailScanning:          in.DisableEmailScanning.ValueBool(),
+		PUAProtection:                 in.PUAProtection.ValueString(),
+		CloudBlockLevel:               in.CloudBlockLevel.ValueString(),
+		CloudExtendedTimeout:          int(in.CloudExtendedTimeout.ValueInt64()),
+		MAPSReporting:                 in.MAPSReporting.ValueString(),
+		SubmitSamplesConsent:          in.SubmitSamplesConsent.ValueString(),
+		EnableNetworkProtection:       in.EnableNetworkProtection.ValueString(),
+		EnableControlledFolderAccess:  in.EnableControlledFolderAccess.ValueString(),
+		SignatureUpdateInterval:       int(in.SignatureUpdateInterval.ValueInt64()),
+		ExclusionPaths:                exclusionPaths,
+		ExclusionExtensions:           exclusionExtensions,
+		ExclusionProcesses:            exclusionProcesses,
+	}
+}
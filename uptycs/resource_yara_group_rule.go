@@ -17,7 +17,8 @@ func YaraGroupRuleResource() resource.Resource {
 }
 
 type yaraGroupRuleResource struct {
-	client *uptycs.Client
+	client   *uptycs.Client
+	settings providerSettings
 }
 
 func (r *yaraGroupRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -29,7 +30,9 @@ func (r *yaraGroupRuleResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	r.client = req.ProviderData.(*uptycs.Client)
+	data := req.ProviderData.(*resourceData)
+	r.client = data.client
+	r.settings = data.settings
 }
 
 func (r *yaraGroupRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -78,6 +81,10 @@ func (r *yaraGroupRuleResource) Read(ctx context.Context, req resource.ReadReque
 }
 
 func (r *yaraGroupRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.settings.denyWrite("create", &resp.Diagnostics) {
+		return
+	}
+
 	// Retrieve values from plan
 	var plan YaraGroupRule
 	diags := req.Plan.Get(ctx, &plan)
@@ -86,6 +93,17 @@ func (r *yaraGroupRuleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	if r.settings.adoptExisting {
+		existing, err := r.client.GetYaraGroupRule(uptycs.YaraGroupRule{Name: plan.Name.ValueString()})
+		if existingID := lookupExisting(existing.ID, err, "yaraGroupRule "+plan.Name.ValueString(), &resp.Diagnostics); len(existingID) > 0 {
+			adoptExisting(ctx, r, existingID, req, resp)
+			return
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	yaraGroupRuleResp, err := r.client.CreateYaraGroupRule(uptycs.YaraGroupRule{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -115,6 +133,10 @@ func (r *yaraGroupRuleResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *yaraGroupRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.settings.denyWrite("update", &resp.Diagnostics) {
+		return
+	}
+
 	var state YaraGroupRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -162,6 +184,10 @@ func (r *yaraGroupRuleResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *yaraGroupRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.settings.denyWrite("delete", &resp.Diagnostics) {
+		return
+	}
+
 	var state YaraGroupRule
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -187,5 +213,8 @@ func (r *yaraGroupRuleResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *yaraGroupRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByName(ctx, req, resp, func(name string) (string, error) {
+		yaraGroupRuleResp, err := r.client.GetYaraGroupRule(uptycs.YaraGroupRule{Name: name})
+		return yaraGroupRuleResp.ID, err
+	})
 }
@@ -0,0 +1,223 @@
+package uptycs
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var (
+	sqlCommentRegex = regexp.MustCompile(`(?s)--[^\n]*|/\*.*?\*/`)
+	sqlParamRegex   = regexp.MustCompile(`:[a-z_][a-z0-9_]*`)
+	sqlQuotedRegex  = regexp.MustCompile(`'(?:[^']|'')*'|"(?:[^"]|"")*"|` + "`[^`]*`")
+	sqlTokenRegex   = regexp.MustCompile(`[a-z_][a-z0-9_]*(?:\.[a-z_][a-z0-9_]*)*|[(),]`)
+)
+
+// sqlKeywords are words that can follow a table name and must not be mistaken
+// for its alias.
+var sqlKeywords = map[string]bool{
+	"as": true, "where": true, "join": true, "inner": true, "left": true, "right": true,
+	"full": true, "outer": true, "cross": true, "natural": true, "on": true, "using": true,
+	"group": true, "order": true, "having": true, "limit": true, "offset": true,
+	"union": true, "except": true, "intersect": true, "window": true, "select": true,
+}
+
+// sqlGroupingKeywords are words that can come right before a parenthesis that
+// opens a subquery or a grouped expression rather than a function call.
+var sqlGroupingKeywords = map[string]bool{
+	"in": true, "exists": true, "from": true, "join": true, "as": true, "on": true,
+	"and": true, "or": true, "not": true, "select": true, "where": true, "using": true,
+	"values": true, "with": true, "union": true, "all": true, "any": true, "some": true,
+	"when": true, "then": true, "else": true, "case": true, "having": true, "by": true,
+	"except": true, "intersect": true,
+}
+
+// tableSchemaCache holds the tenant's table catalogue for one provider
+// instance, so a plan touching many rules only fetches it once.
+type tableSchemaCache struct {
+	client *uptycs.Client
+	once   sync.Once
+	tables map[string]map[string]bool
+	err    error
+}
+
+func newTableSchemaCache(client *uptycs.Client) *tableSchemaCache {
+	return &tableSchemaCache{
+		client: client,
+	}
+}
+
+// schemas returns the columns of every table in the catalogue, keyed by
+// lower-cased table and column name.
+func (c *tableSchemaCache) schemas() (map[string]map[string]bool, error) {
+	c.once.Do(func() {
+		tablesResp, err := c.client.GetTables()
+		if err != nil {
+			c.err = err
+			return
+		}
+
+		c.tables = make(map[string]map[string]bool, len(tablesResp.Items))
+		for _, t := range tablesResp.Items {
+			columns := make(map[string]bool, len(t.Columns))
+			for _, col := range t.Columns {
+				columns[strings.ToLower(col.Name)] = true
+			}
+			c.tables[strings.ToLower(t.Name)] = columns
+		}
+	})
+	return c.tables, c.err
+}
+
+// parseSQLReferences returns the tables a query reads from, keyed by the name
+// or alias they are referred to by, along with every qualifier.column reference.
+// It isn't a full SQL parser: subqueries, table functions and CTEs are skipped
+// rather than resolved.
+func parseSQLReferences(sql string) (map[string]string, [][2]string) {
+	sql = strings.ToLower(sql)
+	sql = sqlCommentRegex.ReplaceAllString(sql, " ")
+	sql = sqlQuotedRegex.ReplaceAllString(sql, " ")
+	sql = sqlParamRegex.ReplaceAllString(sql, " ")
+	tokens := sqlTokenRegex.FindAllString(sql, -1)
+
+	ctes := make(map[string]bool)
+	for i := 0; i+2 < len(tokens); i++ {
+		if (tokens[i] == "with" || tokens[i] == "," || tokens[i] == "recursive") && tokens[i+2] == "as" {
+			ctes[tokens[i+1]] = true
+		}
+	}
+
+	// FROM is also an argument keyword in calls such as extract(hour from
+	// upt_time) or substring(x from 1 for 10), so note which tokens sit
+	// directly inside a function call's parentheses.
+	inCall := make([]bool, len(tokens))
+	calls := make([]bool, 0)
+	for i, t := range tokens {
+		switch t {
+		case "(":
+			prev := ""
+			if i > 0 {
+				prev = tokens[i-1]
+			}
+			calls = append(calls, prev != "" && prev != "(" && prev != ")" && prev != "," && !sqlGroupingKeywords[prev])
+		case ")":
+			if len(calls) > 0 {
+				calls = calls[:len(calls)-1]
+			}
+		default:
+			inCall[i] = len(calls) > 0 && calls[len(calls)-1]
+		}
+	}
+
+	tables := make(map[string]string)
+	tableTokens := make(map[int]bool)
+	for i := 0; i < len(tokens); i++ {
+		if (tokens[i] != "from" && tokens[i] != "join") || inCall[i] {
+			continue
+		}
+		for i+1 < len(tokens) {
+			name := tokens[i+1]
+			if name == "(" || sqlKeywords[name] {
+				break
+			}
+			i++
+			if i+1 < len(tokens) && tokens[i+1] == "(" {
+				// table-valued function
+				break
+			}
+			tableTokens[i] = true
+			if dot := strings.LastIndex(name, "."); dot >= 0 {
+				name = name[dot+1:]
+			}
+			alias := name
+			if i+1 < len(tokens) && tokens[i+1] == "as" {
+				i++
+			}
+			if i+1 < len(tokens) && tokens[i+1] != "," && tokens[i+1] != "(" && tokens[i+1] != ")" && !sqlKeywords[tokens[i+1]] {
+				i++
+				alias = tokens[i]
+			}
+			if !ctes[name] {
+				tables[alias] = name
+			}
+			if i+1 >= len(tokens) || tokens[i+1] != "," {
+				break
+			}
+			i++
+		}
+	}
+
+	columns := make([][2]string, 0)
+	for i, t := range tokens {
+		if tableTokens[i] {
+			// A schema-qualified table such as upt_asset.processes
+			continue
+		}
+		if parts := strings.Split(t, "."); len(parts) == 2 {
+			columns = append(columns, [2]string{parts[0], parts[1]})
+		}
+	}
+
+	return tables, columns
+}
+
+// sqlChanged reports whether the SQL at attributePath differs from the prior
+// state, so problems in rules that aren't being changed don't fail the plan.
+func sqlChanged(ctx context.Context, req resource.ModifyPlanRequest, attributePath path.Path, planned types.String, diags *diag.Diagnostics) bool {
+	if req.State.Raw.IsNull() {
+		return true
+	}
+
+	var prior types.String
+	diags.Append(req.State.GetAttribute(ctx, attributePath, &prior)...)
+	return !prior.Equal(planned)
+}
+
+// validateSQL reports every table or qualified column in sql that isn't in
+// the tenant's table catalogue. They are errors when the SQL is being changed,
+// and warnings when it is already applied, so existing rules are still
+// flagged without blocking unrelated changes. Validation is skipped if the
+// catalogue can't be fetched.
+func validateSQL(ctx context.Context, cache *tableSchemaCache, attributePath path.Path, sql string, changed bool, diags *diag.Diagnostics) {
+	if cache == nil || cache.client == nil {
+		return
+	}
+
+	schemas, err := cache.schemas()
+	if err != nil {
+		tflog.Warn(ctx, "Could not fetch table schemas, skipping SQL validation", map[string]any{"error": err.Error()})
+		return
+	}
+
+	report := diags.AddAttributeWarning
+	if changed {
+		report = diags.AddAttributeError
+	}
+
+	tables, columns := parseSQLReferences(sql)
+	for _, table := range tables {
+		if _, ok := schemas[table]; !ok {
+			report(attributePath, "Unknown table", "Table "+table+" does not exist. See the uptycs_table_schema data source for available tables.")
+		}
+	}
+	for _, c := range columns {
+		table, ok := tables[c[0]]
+		if !ok {
+			continue
+		}
+		tableColumns, ok := schemas[table]
+		if !ok {
+			continue
+		}
+		if !tableColumns[c[1]] {
+			report(attributePath, "Unknown column", "Column "+c[1]+" does not exist in table "+table+".")
+		}
+	}
+}
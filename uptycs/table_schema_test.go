@@ -0,0 +1,122 @@
+package uptycs
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/uptycslabs/uptycs-client-go/uptycs"
+)
+
+func TestParseSQLReferences(t *testing.T) {
+	tests := []struct {
+		name    string
+		sql     string
+		tables  map[string]string
+		columns [][2]string
+	}{
+		{
+			name:    "single table",
+			sql:     "select * from processes limit 2 :to;",
+			tables:  map[string]string{"processes": "processes"},
+			columns: [][2]string{},
+		},
+		{
+			name:    "aliases and joins",
+			sql:     "SELECT p.name, u.username FROM processes AS p JOIN users u ON p.uid = u.uid",
+			tables:  map[string]string{"p": "processes", "u": "users"},
+			columns: [][2]string{{"p", "name"}, {"u", "username"}, {"p", "uid"}, {"u", "uid"}},
+		},
+		{
+			name:    "comma separated tables",
+			sql:     "select * from processes p, users where p.uid = 0",
+			tables:  map[string]string{"p": "processes", "users": "users"},
+			columns: [][2]string{{"p", "uid"}},
+		},
+		{
+			name:    "schema qualified table",
+			sql:     "select p.name from upt_asset.processes p join upt_asset.users on p.uid = users.uid",
+			tables:  map[string]string{"p": "processes", "users": "users"},
+			columns: [][2]string{{"p", "name"}, {"p", "uid"}, {"users", "uid"}},
+		},
+		{
+			name:    "comments, strings and parameters",
+			sql:     "select * from processes -- from users\nwhere name = 'from groups' and upt_day >= :from /* from hosts */",
+			tables:  map[string]string{"processes": "processes"},
+			columns: [][2]string{},
+		},
+		{
+			name:    "extract is not a table",
+			sql:     "select extract(hour from upt_time) as hour from process_events",
+			tables:  map[string]string{"process_events": "process_events"},
+			columns: [][2]string{},
+		},
+		{
+			name:    "substring is not a table",
+			sql:     "select substring(path from 1 for 10) from processes",
+			tables:  map[string]string{"processes": "processes"},
+			columns: [][2]string{},
+		},
+		{
+			name:    "subquery inside in",
+			sql:     "select * from processes where uid in (select uid from users)",
+			tables:  map[string]string{"processes": "processes", "users": "users"},
+			columns: [][2]string{},
+		},
+		{
+			name:    "ctes and table functions are skipped",
+			sql:     "with recent as (select * from process_events) select * from recent, json_each(x)",
+			tables:  map[string]string{"process_events": "process_events"},
+			columns: [][2]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables, columns := parseSQLReferences(tt.sql)
+			if !reflect.DeepEqual(tables, tt.tables) {
+				t.Errorf("tables = %v, want %v", tables, tt.tables)
+			}
+			if !reflect.DeepEqual(columns, tt.columns) {
+				t.Errorf("columns = %v, want %v", columns, tt.columns)
+			}
+		})
+	}
+}
+
+func TestValidateSQL(t *testing.T) {
+	cache := newTableSchemaCache(&uptycs.Client{})
+	cache.once.Do(func() {
+		cache.tables = map[string]map[string]bool{
+			"processes": {"name": true, "uid": true},
+		}
+	})
+
+	tests := []struct {
+		name    string
+		sql     string
+		changed bool
+		errors  int
+		warns   int
+	}{
+		{name: "valid", sql: "select p.name from processes p", changed: true},
+		{name: "unknown table", sql: "select * from proceses", changed: true, errors: 1},
+		{name: "unknown column", sql: "select p.nam from processes p", changed: true, errors: 1},
+		{name: "unchanged SQL only warns", sql: "select p.nam from proceses p", changed: false, warns: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateSQL(context.Background(), cache, path.Root("rule"), tt.sql, tt.changed, &diags)
+			if got := diags.ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d: %v", got, tt.errors, diags.Errors())
+			}
+			if got := diags.WarningsCount(); got != tt.warns {
+				t.Errorf("got %d warnings, want %d: %v", got, tt.warns, diags.Warnings())
+			}
+		})
+	}
+}
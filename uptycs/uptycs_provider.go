@@ -3,6 +3,7 @@ package uptycs
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/uptycslabs/uptycs-client-go/uptycs"
 	"os"
+	"strings"
 )
 
 func New() provider.Provider {
@@ -19,10 +21,57 @@ func New() provider.Provider {
 type UptycsProvider struct{} //revive:disable-line:exported
 
 type uptycsProviderData struct {
-	Host       types.String `tfsdk:"host"`
-	CustomerID types.String `tfsdk:"customer_id"`
-	APIKey     types.String `tfsdk:"api_key"`
-	APISecret  types.String `tfsdk:"api_secret"`
+	Host                     types.String `tfsdk:"host"`
+	CustomerID               types.String `tfsdk:"customer_id"`
+	APIKey                   types.String `tfsdk:"api_key"`
+	APISecret                types.String `tfsdk:"api_secret"`
+	AdoptExisting            types.Bool   `tfsdk:"adopt_existing"`
+	AllowSystemObjectChanges types.Bool   `tfsdk:"allow_system_object_changes"`
+	NamePrefix               types.String `tfsdk:"name_prefix"`
+	DefaultAlertTags         types.List   `tfsdk:"default_alert_tags"`
+	DefaultEventTags         types.List   `tfsdk:"default_event_tags"`
+	ReadOnly                 types.Bool   `tfsdk:"read_only"`
+}
+
+// resourceData is passed to every resource's Configure.
+type resourceData struct {
+	client       *uptycs.Client
+	settings     providerSettings
+	exceptions   *exceptionCache
+	permissions  *permissionCache
+	tableSchemas *tableSchemaCache
+}
+
+type providerSettings struct {
+	adoptExisting            bool
+	allowSystemObjectChanges bool
+	namePrefix               string
+	defaultAlertTags         []string
+	defaultEventTags         []string
+	readOnly                 bool
+}
+
+// denyWrite reports whether the provider is read-only, adding an error
+// for the attempted action if so.
+func (s providerSettings) denyWrite(action string, diags *diag.Diagnostics) bool {
+	if !s.readOnly {
+		return false
+	}
+	diags.AddError(
+		"Provider is read-only",
+		"Refusing to "+action+": the provider is configured with read_only = true.",
+	)
+	return true
+}
+
+// updateName returns the name to send when updating an object that is
+// currently named remoteName. Objects created or imported before name_prefix
+// was set don't carry the prefix, and keep their name instead of being renamed.
+func (s providerSettings) updateName(name, remoteName string) string {
+	if !strings.HasPrefix(remoteName, s.namePrefix) {
+		return name
+	}
+	return s.namePrefix + name
 }
 
 func (p *UptycsProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -36,6 +85,32 @@ func (p *UptycsProvider) Schema(_ context.Context, req provider.SchemaRequest, r
 			"api_key":     schema.StringAttribute{Optional: true},
 			"api_secret":  schema.StringAttribute{Optional: true, Sensitive: true},
 			"customer_id": schema.StringAttribute{Optional: true, Sensitive: true},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Adopt objects that already exist with the same name on create, instead of failing with a duplicate error.",
+			},
+			"allow_system_object_changes": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Allow updating and deleting system tags, system tag rules and internal alert rules and querypacks shipped by Uptycs.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Prefix added to the name of every alert rule, event rule and tag rule created by the provider. Rules that were created or imported without the prefix keep their name on update.",
+			},
+			"default_alert_tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags added to every alert rule created or updated by the provider.",
+			},
+			"default_event_tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags added to every event rule created or updated by the provider.",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail every create, update and delete before any API call is made.",
+			},
 		},
 	}
 }
@@ -152,6 +227,14 @@ func (p *UptycsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	var defaultAlertTags []string
+	resp.Diagnostics.Append(config.DefaultAlertTags.ElementsAs(ctx, &defaultAlertTags, false)...)
+	var defaultEventTags []string
+	resp.Diagnostics.Append(config.DefaultEventTags.ElementsAs(ctx, &defaultEventTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create a new uptycs client and set it to the provider.client
 	client, err := uptycs.NewClient(uptycs.Config{
 		Host:       host,
@@ -168,7 +251,20 @@ func (p *UptycsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &resourceData{
+		client:       client,
+		exceptions:   newExceptionCache(client),
+		permissions:  newPermissionCache(client),
+		tableSchemas: newTableSchemaCache(client),
+		settings: providerSettings{
+			adoptExisting:            config.AdoptExisting.ValueBool(),
+			allowSystemObjectChanges: config.AllowSystemObjectChanges.ValueBool(),
+			namePrefix:               config.NamePrefix.ValueString(),
+			defaultAlertTags:         defaultAlertTags,
+			defaultEventTags:         defaultEventTags,
+			readOnly:                 config.ReadOnly.ValueBool(),
+		},
+	}
 
 	tflog.Info(ctx, "Configured Uptycs client", map[string]any{"success": true})
 
@@ -177,21 +273,31 @@ func (p *UptycsProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *UptycsProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		AlertRuleResource,
+		AlertRuleDestinationResource,
+		AlertRuleExceptionAttachmentResource,
+		AuditGroupResource,
+		AuditRuleResource,
 		ComplianceProfileResource,
 		CustomProfileResource,
 		DestinationResource,
+		DNSBlockRuleResource,
 		EventExcludeProfileResource,
 		EventRuleResource,
 		ExceptionResource,
 		FilePathGroupResource,
 		FlagProfileResource,
+		ImageLoadExclusionResource,
 		LookupTableResource,
+		ProcessBlockRuleResource,
+		PrometheusTargetResource,
 		QuerypackResource,
+		RedactionResource,
 		RegistryPathResource,
 		RoleResource,
 		TagResource,
 		TagRuleResource,
 		UserResource,
+		WindowsDefenderPreferenceResource,
 		YaraGroupRuleResource,
 	}
 }
@@ -199,26 +305,43 @@ func (p *UptycsProvider) Resources(_ context.Context) []func() resource.Resource
 func (p *UptycsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		AlertRuleDataSource,
+		AlertRulesDataSource,
 		AlertRuleCategoryDataSource,
 		AssetGroupRuleDataSource,
+		AssetsDataSource,
 		AtcQueryDataSource,
 		AuditConfigurationDataSource,
+		AuditGroupDataSource,
+		AuditRuleDataSource,
 		ComplianceProfileDataSource,
 		CustomProfileDataSource,
 		DestinationDataSource,
+		DNSBlockRuleDataSource,
 		EventRuleDataSource,
 		EventExcludeProfileDataSource,
 		ExceptionDataSource,
 		FilePathGroupDataSource,
 		FlagProfileDataSource,
+		ImageLoadExclusionDataSource,
 		LookupTableDataSource,
+		MITRETechniquesDataSource,
 		ObjectGroupDataSource,
+		PermissionsDataSource,
+		QueryDataSource,
+		ProcessBlockRuleDataSource,
+		PrometheusTargetDataSource,
 		QuerypackDataSource,
+		RedactionDataSource,
 		RegistryPathDataSource,
 		RoleDataSource,
+		RolesDataSource,
+		TableSchemaDataSource,
 		TagDataSource,
 		TagRuleDataSource,
+		TagsDataSource,
 		UserDataSource,
+		UsersDataSource,
+		WindowsDefenderPreferenceDataSource,
 		YaraGroupRuleDataSource,
 	}
 }